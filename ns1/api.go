@@ -0,0 +1,23 @@
+package ns1
+
+import (
+	"net/http"
+
+	ns1 "gopkg.in/ns1/ns1-go.v2/rest"
+)
+
+// apiRequest performs a request against an NS1 API endpoint that the vendored
+// SDK does not cover, decoding the response body into v if it is not nil.
+func apiRequest(client *ns1.Client, method, path string, body, v interface{}) (*http.Response, error) {
+	req, err := client.NewRequest(method, path, body)
+	if err != nil {
+		return nil, err
+	}
+	return client.Do(req, v)
+}
+
+// apiNotFound reports whether err is a 404 response of the NS1 API.
+func apiNotFound(err error) bool {
+	e, ok := err.(*ns1.Error)
+	return ok && e.Resp != nil && e.Resp.StatusCode == http.StatusNotFound
+}
@@ -16,11 +16,24 @@ import (
 	ns1 "gopkg.in/ns1/ns1-go.v2/rest"
 )
 
+// providerMeta is the meta of every resource and data source.
+type providerMeta struct {
+	client *ns1.Client
+
+	// recordDeleteQPSThreshold is the provider level
+	// delete_protection_qps_threshold of records, if set.
+	recordDeleteQPSThreshold *float64
+}
+
 // Config for NS1 API
 type Config struct {
 	Key       string
 	Endpoint  string
 	IgnoreSSL bool
+
+	// RecordDeleteQPSThreshold is the provider level
+	// delete_protection_qps_threshold of records, if set.
+	RecordDeleteQPSThreshold *float64
 }
 
 // Client returns a new NS1 client.
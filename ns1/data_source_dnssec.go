@@ -0,0 +1,155 @@
+package ns1
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceDNSSEC() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"digest_type": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      2,
+				ValidateFunc: validateDSDigestType,
+			},
+			"ttl": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"dnskey": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"flags": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"protocol": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"algorithm": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"public_key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key_tag": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"ds_ttl": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"ds": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_tag": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"algorithm": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"digest_type": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"digest": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		Read: dataSourceDNSSECRead,
+	}
+}
+
+func dataSourceDNSSECRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+	zone := d.Get("zone").(string)
+	z, err := getZoneDNSSEC(client, zone)
+	if err != nil {
+		return err
+	}
+	if z.Keys == nil || z.Delegation == nil {
+		return fmt.Errorf("DNSSEC is not enabled on zone %s", zone)
+	}
+
+	keys := make([]map[string]interface{}, len(z.Keys.Keys))
+	for i, k := range z.Keys.Keys {
+		tag, err := k.keyTag()
+		if err != nil {
+			return err
+		}
+		flags, _ := k.Flags.Int64()
+		protocol, _ := k.Protocol.Int64()
+		algorithm, _ := k.Algorithm.Int64()
+		keys[i] = map[string]interface{}{
+			"flags":      int(flags),
+			"protocol":   int(protocol),
+			"algorithm":  int(algorithm),
+			"public_key": k.PublicKey,
+			"key_tag":    tag,
+		}
+	}
+
+	digestType := d.Get("digest_type").(int)
+	ds := make([]map[string]interface{}, len(z.Delegation.Keys))
+	for i, k := range z.Delegation.Keys {
+		tag, err := k.keyTag()
+		if err != nil {
+			return err
+		}
+		digest, err := k.ds(zone, digestType)
+		if err != nil {
+			return err
+		}
+		algorithm, _ := k.Algorithm.Int64()
+		ds[i] = map[string]interface{}{
+			"key_tag":     tag,
+			"algorithm":   int(algorithm),
+			"digest_type": digestType,
+			"digest":      digest,
+		}
+	}
+
+	d.SetId(zone)
+	d.Set("ttl", z.Keys.TTL)
+	if err := d.Set("dnskey", keys); err != nil {
+		return fmt.Errorf("[DEBUG] Error setting dnskey for: %s, error: %#v", zone, err)
+	}
+	d.Set("ds_ttl", z.Delegation.TTL)
+	if err := d.Set("ds", ds); err != nil {
+		return fmt.Errorf("[DEBUG] Error setting ds for: %s, error: %#v", zone, err)
+	}
+	return nil
+}
+
+// validateDSDigestType (schema helper) checks that a DS digest type is
+// supported.
+func validateDSDigestType(v interface{}, k string) (ws []string, es []error) {
+	if _, ok := dsDigests[v.(int)]; !ok {
+		es = append(es, fmt.Errorf("%q: expecting one of 1 (SHA-1), 2 (SHA-256) or 4 (SHA-384); got %d", k, v.(int)))
+	}
+	return
+}
@@ -0,0 +1,72 @@
+package ns1
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceDNSSEC_basic(t *testing.T) {
+	dataSourceName := "data.ns1_dnssec.test"
+	zoneName := fmt.Sprintf(
+		"terraform-test-%s.io",
+		acctest.RandStringFromCharSet(15, acctest.CharSetAlphaNum),
+	)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDNSSECBasic(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ns1_zone.it", "dnssec", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "ds.0.digest_type", "2"),
+					resource.TestMatchResourceAttr(dataSourceName, "ds.0.digest", regexp.MustCompile(`^[0-9A-F]{64}$`)),
+					resource.TestMatchResourceAttr(dataSourceName, "dnskey.0.public_key", regexp.MustCompile(`.+`)),
+				),
+			},
+		},
+	})
+}
+
+// Example key and DS record from RFC 4034 section 5.4.
+func TestDNSKeyDS(t *testing.T) {
+	k := &dnskey{
+		Flags:     "256",
+		Protocol:  "3",
+		Algorithm: "5",
+		PublicKey: "AQOeiiR0GOMYkDshWoSKz9XzfwJr1AYtsmx3TGkJaNXVbfi/2pHm822aJ5iI9BMzNXxeYCmZDRD99WYwYqUSdjMmmAphXdvxegXd/M5+X7OrzKBaMbCVdFLUUh6DhweJBjEVv5f2wwjM9XzcnOf+EPbtG9DMBmADjFDc2w/rljwvFw==",
+	}
+
+	tag, err := k.keyTag()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if tag != 60485 {
+		t.Errorf("key tag: got: %d want: %d", tag, 60485)
+	}
+
+	digest, err := k.ds("dskey.example.com", 1)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if expected := "2BB183AF5F22588179A53B0A98631FAD1A292118"; digest != expected {
+		t.Errorf("digest: got: %s want: %s", digest, expected)
+	}
+}
+
+func testAccDataSourceDNSSECBasic(zoneName string) string {
+	return fmt.Sprintf(`resource "ns1_zone" "it" {
+  zone   = "%s"
+  dnssec = true
+}
+
+data "ns1_dnssec" "test" {
+  zone = "${ns1_zone.it.zone}"
+}
+`, zoneName)
+}
@@ -0,0 +1,62 @@
+package ns1
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceNetworks() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"networks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"label": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		Read: dataSourceNetworksRead,
+	}
+}
+
+func dataSourceNetworksRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+	networks, err := listNetworks(client)
+	if err != nil {
+		return err
+	}
+
+	ids := make([]string, len(networks))
+	out := make([]map[string]interface{}, len(networks))
+	for i, n := range networks {
+		ids[i] = strconv.Itoa(n.ID)
+		out[i] = map[string]interface{}{
+			"id":    n.ID,
+			"name":  n.Name,
+			"label": n.Label,
+		}
+	}
+	d.SetId(strconv.Itoa(hashcode.String(strings.Join(ids, ","))))
+	if err := d.Set("networks", out); err != nil {
+		return fmt.Errorf("[DEBUG] Error setting networks, error: %#v", err)
+	}
+	return nil
+}
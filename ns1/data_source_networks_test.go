@@ -0,0 +1,52 @@
+package ns1
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceNetworks_basic(t *testing.T) {
+	dataSourceName := "data.ns1_networks.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNetworksBasic,
+				Check: resource.ComposeTestCheckFunc(
+					// Network 0, the NS1 Global Network, is available to
+					// every account.
+					resource.TestCheckResourceAttr(dataSourceName, "networks.0.id", "0"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "networks.0.name"),
+				),
+			},
+		},
+	})
+}
+
+func TestCheckNetworkIDs(t *testing.T) {
+	networks := []*network{
+		{ID: 0, Name: "NS1 Global Network", Label: "nsone"},
+		{ID: 5, Name: "Private", Label: "private"},
+	}
+	cases := []struct {
+		ids   []int
+		valid bool
+	}{
+		{nil, true},
+		{[]int{0}, true},
+		{[]int{0, 5}, true},
+		{[]int{1}, false},
+		{[]int{0, 6}, false},
+	}
+	for _, c := range cases {
+		err := checkNetworkIDs("networks", c.ids, networks)
+		if (err == nil) != c.valid {
+			t.Errorf("%v: got error %v, want valid %t", c.ids, err, c.valid)
+		}
+	}
+}
+
+const testAccDataSourceNetworksBasic = `data "ns1_networks" "test" {}
+`
@@ -0,0 +1,85 @@
+package ns1
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	ns1 "gopkg.in/ns1/ns1-go.v2/rest"
+)
+
+func dataSourceQPS() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: recordTypeStringEnum.ValidateFunc,
+			},
+			"qps": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+		Read: dataSourceQPSRead,
+	}
+}
+
+// getQPS returns the current queries per second of the account when zone is
+// empty, of zone when domain and t are empty, or else of the record.
+func getQPS(client *ns1.Client, zone, domain, t string) (float64, error) {
+	var qps float32
+	var err error
+	switch {
+	case zone == "":
+		qps, _, err = client.Stats.GetQPS()
+	case domain == "" && t == "":
+		qps, _, err = client.Stats.GetZoneQPS(zone)
+	default:
+		qps, _, err = client.Stats.GetRecordQPS(zone, domain, t)
+	}
+	if err != nil {
+		return 0, err
+	}
+	// Convert through the shortest decimal representation, so that 0.1 isn't
+	// read as 0.10000000149011612.
+	return strconv.ParseFloat(strconv.FormatFloat(float64(qps), 'f', -1, 32), 64)
+}
+
+func dataSourceQPSRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+	zone := d.Get("zone").(string)
+	domain := d.Get("domain").(string)
+	t := d.Get("type").(string)
+	if (domain == "") != (t == "") {
+		return errors.New("domain and type must be set together, to read the QPS of a record")
+	}
+	if domain != "" && zone == "" {
+		return errors.New("zone must be set to read the QPS of a record")
+	}
+
+	qps, err := getQPS(client, zone, domain, t)
+	if err != nil {
+		return fmt.Errorf("could not read QPS: %s", err)
+	}
+
+	id := "account"
+	if zone != "" {
+		id = strings.Join([]string{zone, domain, t}, "/")
+		id = strings.TrimRight(id, "/")
+	}
+	d.SetId(id)
+	d.Set("qps", qps)
+	return nil
+}
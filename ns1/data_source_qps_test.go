@@ -0,0 +1,67 @@
+package ns1
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceQPS_basic(t *testing.T) {
+	zoneName := fmt.Sprintf(
+		"terraform-test-%s.io",
+		acctest.RandStringFromCharSet(15, acctest.CharSetAlphaNum),
+	)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceQPSBasic(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ns1_qps.account", "id", "account"),
+					resource.TestCheckResourceAttrSet("data.ns1_qps.account", "qps"),
+					// Nothing queries the test zone.
+					resource.TestCheckResourceAttr("data.ns1_qps.zone", "qps", "0"),
+					resource.TestCheckResourceAttr("data.ns1_qps.record", "qps", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceQPS_invalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "ns1_qps" "it" {
+  zone   = "terraform-test.io"
+  domain = "www.terraform-test.io"
+}
+`,
+				ExpectError: regexp.MustCompile(`domain and type must be set together`),
+			},
+		},
+	})
+}
+
+func testAccDataSourceQPSBasic(zoneName string) string {
+	return testAccZoneWithRecord(zoneName) + `
+data "ns1_qps" "account" {}
+
+data "ns1_qps" "zone" {
+  zone = "${ns1_zone.it.zone}"
+}
+
+data "ns1_qps" "record" {
+  zone   = "${ns1_record.www.zone}"
+  domain = "${ns1_record.www.domain}"
+  type   = "${ns1_record.www.type}"
+}
+`
+}
@@ -0,0 +1,78 @@
+package ns1
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+
+	"gopkg.in/ns1/ns1-go.v2/rest/model/dns"
+)
+
+func TestAccDataSourceRecord_basic(t *testing.T) {
+	var record dns.Record
+	dataSourceName := "data.ns1_record.test"
+	zoneName := fmt.Sprintf(
+		"terraform-test-%s.io",
+		acctest.RandStringFromCharSet(15, acctest.CharSetAlphaNum),
+	)
+	domainName := fmt.Sprintf("test.%s", zoneName)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceRecordBasic(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordExists(dataSourceName, &record),
+					resource.TestCheckResourceAttr(dataSourceName, "domain", domainName),
+					resource.TestCheckResourceAttr(dataSourceName, "type", "CNAME"),
+					resource.TestCheckResourceAttr(dataSourceName, "ttl", "60"),
+					resource.TestCheckResourceAttr(dataSourceName, "answers.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "answers.0.answer", fmt.Sprintf("test1.%s", zoneName)),
+					resource.TestCheckResourceAttr(dataSourceName, "answers.0.region", "cal"),
+					resource.TestCheckResourceAttr(dataSourceName, "regions.0.name", "cal"),
+					resource.TestCheckResourceAttr(dataSourceName, "filters.0.filter", "up"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceRecordBasic(zoneName string) string {
+	return fmt.Sprintf(`resource "ns1_zone" "it" {
+  zone = "%s"
+}
+
+resource "ns1_record" "it" {
+  zone   = "${ns1_zone.it.zone}"
+  domain = "test.${ns1_zone.it.zone}"
+  type   = "CNAME"
+  ttl    = 60
+
+  answers {
+    answer = "test1.${ns1_zone.it.zone}"
+    region = "cal"
+  }
+
+  regions {
+    name = "cal"
+    meta = {
+      up = true
+    }
+  }
+
+  filters {
+    filter = "up"
+  }
+}
+
+data "ns1_record" "test" {
+  zone   = "${ns1_record.it.zone}"
+  domain = "${ns1_record.it.domain}"
+  type   = "${ns1_record.it.type}"
+}
+`, zoneName)
+}
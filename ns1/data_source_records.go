@@ -0,0 +1,154 @@
+package ns1
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"gopkg.in/ns1/ns1-go.v2/rest/model/dns"
+)
+
+// zoneRecordSchema describes an entry of a zones' record list, as returned
+// in dns.Zone.Records.
+var zoneRecordSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"domain": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"ttl": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"short_answers": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"tier": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"link": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	},
+}
+
+func dataSourceRecords() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: recordTypeStringEnum.ValidateFunc,
+			},
+			"domain_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRegexp,
+			},
+			"linked": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     zoneRecordSchema,
+			},
+		},
+		Read: dataSourceRecordsRead,
+	}
+}
+
+// zoneRecordFilter selects entries of a zones' record list. Zero values match
+// everything.
+type zoneRecordFilter struct {
+	Type   string
+	Domain *regexp.Regexp
+	Linked *bool
+}
+
+func (f zoneRecordFilter) match(r *dns.ZoneRecord) bool {
+	if f.Type != "" && r.Type != f.Type {
+		return false
+	}
+	if f.Domain != nil && !f.Domain.MatchString(r.Domain) {
+		return false
+	}
+	if f.Linked != nil && (r.Link != "") != *f.Linked {
+		return false
+	}
+	return true
+}
+
+func zoneRecordsToMaps(records []*dns.ZoneRecord, f zoneRecordFilter) []map[string]interface{} {
+	out := make([]map[string]interface{}, 0, len(records))
+	for _, r := range records {
+		if !f.match(r) {
+			continue
+		}
+		m := map[string]interface{}{
+			"id":            r.ID,
+			"domain":        r.Domain,
+			"type":          r.Type,
+			"ttl":           r.TTL,
+			"short_answers": r.ShortAns,
+			"link":          r.Link,
+		}
+		if tier, err := r.Tier.Int64(); err == nil {
+			m["tier"] = int(tier)
+		}
+		out = append(out, m)
+	}
+	return out
+}
+
+func dataSourceRecordsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+	z, _, err := client.Zones.Get(d.Get("zone").(string))
+	if err != nil {
+		return err
+	}
+
+	f := zoneRecordFilter{
+		Type: d.Get("type").(string),
+	}
+	if v, ok := d.GetOk("domain_regex"); ok {
+		f.Domain = regexp.MustCompile(v.(string))
+	}
+	if v, ok := d.GetOkExists("linked"); ok {
+		linked := v.(bool)
+		f.Linked = &linked
+	}
+
+	d.SetId(z.ID)
+	if err := d.Set("records", zoneRecordsToMaps(z.Records, f)); err != nil {
+		return fmt.Errorf("[DEBUG] Error setting records for: %s, error: %#v", z.Zone, err)
+	}
+	return nil
+}
+
+// validateRegexp (schema helper) checks that a string is a valid regular
+// expression.
+func validateRegexp(v interface{}, k string) (ws []string, es []error) {
+	if _, err := regexp.Compile(v.(string)); err != nil {
+		es = append(es, fmt.Errorf("%q: %s", k, err))
+	}
+	return
+}
@@ -0,0 +1,96 @@
+package ns1
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+
+	"gopkg.in/ns1/ns1-go.v2/rest/model/dns"
+)
+
+func TestAccDataSourceRecords_basic(t *testing.T) {
+	dataSourceName := "data.ns1_records.test"
+	zoneName := fmt.Sprintf(
+		"terraform-test-%s.io",
+		acctest.RandStringFromCharSet(15, acctest.CharSetAlphaNum),
+	)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceRecordsBasic(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "records.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.0.domain", fmt.Sprintf("a.%s", zoneName)),
+					resource.TestCheckResourceAttr(dataSourceName, "records.0.type", "A"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.0.ttl", "60"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.0.short_answers.0", "1.2.3.4"),
+				),
+			},
+		},
+	})
+}
+
+func TestZoneRecordFilter(t *testing.T) {
+	records := []*dns.ZoneRecord{
+		{Domain: "a.example.io", Type: "A"},
+		{Domain: "b.example.io", Type: "A", Link: "a.example.io"},
+		{Domain: "a.example.io", Type: "AAAA"},
+	}
+	linked, unlinked := true, false
+	cases := []struct {
+		filter   zoneRecordFilter
+		expected int
+	}{
+		{zoneRecordFilter{}, 3},
+		{zoneRecordFilter{Type: "A"}, 2},
+		{zoneRecordFilter{Domain: regexp.MustCompile(`^a\.`)}, 2},
+		{zoneRecordFilter{Linked: &linked}, 1},
+		{zoneRecordFilter{Type: "A", Linked: &unlinked}, 1},
+	}
+	for i, c := range cases {
+		if got := len(zoneRecordsToMaps(records, c.filter)); got != c.expected {
+			t.Errorf("case %d: got %d records, want %d", i, got, c.expected)
+		}
+	}
+}
+
+func testAccDataSourceRecordsBasic(zoneName string) string {
+	return fmt.Sprintf(`resource "ns1_zone" "it" {
+  zone = "%s"
+}
+
+resource "ns1_record" "a" {
+  zone   = "${ns1_zone.it.zone}"
+  domain = "a.${ns1_zone.it.zone}"
+  type   = "A"
+  ttl    = 60
+
+  answers {
+    answer = "1.2.3.4"
+  }
+}
+
+resource "ns1_record" "cname" {
+  zone   = "${ns1_zone.it.zone}"
+  domain = "b.${ns1_zone.it.zone}"
+  type   = "CNAME"
+  ttl    = 60
+
+  answers {
+    answer = "${ns1_record.a.domain}"
+  }
+}
+
+data "ns1_records" "test" {
+  zone         = "${ns1_record.cname.zone}"
+  type         = "A"
+  domain_regex = "^a\\."
+}
+`, zoneName)
+}
@@ -1,11 +1,8 @@
 package ns1
 
 import (
-	"strings"
-
 	"github.com/hashicorp/terraform/helper/schema"
 
-	ns1 "gopkg.in/ns1/ns1-go.v2/rest"
 	"gopkg.in/ns1/ns1-go.v2/rest/model/dns"
 )
 
@@ -44,16 +41,30 @@ func dataSourceZone() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"primary_port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"additional_primaries": {
 				Type:     schema.TypeList,
-				Optional: true,
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
 				},
 			},
 			"dns_servers": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeList,
 				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"hostmaster": {
 				Type:     schema.TypeString,
@@ -64,6 +75,20 @@ func dataSourceZone() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeInt},
 			},
+			"network_pools": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"meta": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+			"records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     zoneRecordSchema,
+			},
 		},
 		Read: dataSourceZoneRead,
 	}
@@ -78,16 +103,22 @@ func dataSourceZoneToResourceData(d *schema.ResourceData, z *dns.Zone) {
 	d.Set("retry", z.Retry)
 	d.Set("expiry", z.Expiry)
 	d.Set("networks", z.NetworkIDs)
-	d.Set("dns_servers", strings.Join(z.DNSServers[:], ","))
+	d.Set("network_pools", z.NetworkPools)
+	d.Set("dns_servers", z.DNSServers)
 	d.Set("link", z.Link)
+	if z.Meta != nil {
+		d.Set("meta", z.Meta.StringMap())
+	}
+	d.Set("records", zoneRecordsToMaps(z.Records, zoneRecordFilter{}))
 	if z.Secondary != nil && z.Secondary.Enabled {
 		d.Set("primary", z.Secondary.PrimaryIP)
-		d.Set("additional_primaries", z.Secondary.OtherIPs)
+		d.Set("primary_port", z.Secondary.PrimaryPort)
+		d.Set("additional_primaries", additionalPrimariesToMaps(z.Secondary))
 	}
 }
 
 func dataSourceZoneRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ns1.Client)
+	client := meta.(*providerMeta).client
 	z, _, err := client.Zones.Get(d.Get("zone").(string))
 	if err != nil {
 		return err
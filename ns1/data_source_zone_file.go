@@ -0,0 +1,228 @@
+package ns1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"gopkg.in/ns1/ns1-go.v2/rest/model/dns"
+)
+
+// zoneFileNameFields lists, per record type, the RDATA fields holding a
+// domain name. These are rendered fully qualified in the zone file.
+var zoneFileNameFields = map[string][]int{
+	"AFSDB": {1},
+	"CNAME": {0},
+	"DNAME": {0},
+	"MX":    {1},
+	"NAPTR": {5},
+	"NS":    {0},
+	"PTR":   {0},
+	"RP":    {0, 1},
+	"SRV":   {3},
+}
+
+func dataSourceZoneFile() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"serial": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"zone_file": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Read: dataSourceZoneFileRead,
+	}
+}
+
+func dataSourceZoneFileRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+	z, _, err := client.Zones.Get(d.Get("zone").(string))
+	if err != nil {
+		return err
+	}
+
+	records := make([]*dns.Record, 0, len(z.Records))
+	for _, zr := range z.Records {
+		r, _, err := client.Records.Get(z.Zone, zr.Domain, zr.Type)
+		if err != nil {
+			return fmt.Errorf("could not read record %s %s: %s", zr.Domain, zr.Type, err)
+		}
+		records = append(records, r)
+	}
+
+	content, err := renderZoneFile(z, records)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(z.ID)
+	d.Set("serial", z.Serial)
+	d.Set("zone_file", content)
+	return nil
+}
+
+// renderZoneFile renders a zone and its records as a BIND zone file. Records
+// are sorted by domain and type so that the output is stable between reads.
+// Anything BIND cannot express (linked records, ALIAS records, metadata,
+// regions and filter chains) is written to a trailing comment section, one
+// JSON document per record.
+func renderZoneFile(z *dns.Zone, records []*dns.Record) (string, error) {
+	sorted := make([]*dns.Record, len(records))
+	copy(sorted, records)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Domain != sorted[j].Domain {
+			return sorted[i].Domain < sorted[j].Domain
+		}
+		return sorted[i].Type < sorted[j].Type
+	})
+
+	var b bytes.Buffer
+	mname := "."
+	if len(z.DNSServers) > 0 {
+		mname = fqdn(z.DNSServers[0])
+	}
+	fmt.Fprintf(&b, "$ORIGIN %s\n", fqdn(z.Zone))
+	fmt.Fprintf(&b, "$TTL %d\n", z.TTL)
+	fmt.Fprintf(&b, "@ %d IN SOA %s %s (\n", z.TTL, mname, hostmasterToRName(z.Hostmaster))
+	fmt.Fprintf(&b, "\t%d ; serial\n", z.Serial)
+	fmt.Fprintf(&b, "\t%d ; refresh\n", z.Refresh)
+	fmt.Fprintf(&b, "\t%d ; retry\n", z.Retry)
+	fmt.Fprintf(&b, "\t%d ; expiry\n", z.Expiry)
+	fmt.Fprintf(&b, "\t%d ; nx_ttl\n", z.NxTTL)
+	b.WriteString(")\n")
+
+	steering := make([]string, 0)
+	for _, r := range sorted {
+		owner := fqdn(r.Domain)
+		if r.Link != "" {
+			fmt.Fprintf(&b, "; %s %d IN %s linked to %s\n", owner, r.TTL, r.Type, r.Link)
+		} else {
+			for _, a := range r.Answers {
+				line := fmt.Sprintf("%s %d IN %s %s", owner, r.TTL, r.Type, zoneFileRdata(r.Type, a.Rdata))
+				if r.Type == "ALIAS" {
+					// ALIAS is an NS1 extension with no BIND equivalent.
+					line = "; " + line
+				}
+				b.WriteString(line + "\n")
+			}
+		}
+
+		s, err := recordSteering(r)
+		if err != nil {
+			return "", err
+		}
+		if s != "" {
+			steering = append(steering, fmt.Sprintf("; %s %s %s", owner, r.Type, s))
+		}
+	}
+
+	if len(steering) > 0 {
+		b.WriteString(";\n; NS1 steering metadata\n")
+		for _, s := range steering {
+			b.WriteString(s + "\n")
+		}
+	}
+	return b.String(), nil
+}
+
+// recordSteering returns the NS1 specific configuration of a record as a
+// JSON document, or an empty string if the record has none.
+func recordSteering(r *dns.Record) (string, error) {
+	s := make(map[string]interface{})
+	if r.Meta != nil {
+		if m := r.Meta.StringMap(); len(m) > 0 {
+			s["meta"] = m
+		}
+	}
+	if len(r.Filters) > 0 {
+		filters := make([]map[string]interface{}, len(r.Filters))
+		for i, f := range r.Filters {
+			filters[i] = map[string]interface{}{
+				"filter":   f.Type,
+				"disabled": f.Disabled,
+				"config":   f.Config,
+			}
+		}
+		s["filters"] = filters
+	}
+	if len(r.Regions) > 0 {
+		regions := make(map[string]interface{}, len(r.Regions))
+		for name, region := range r.Regions {
+			regions[name] = region.Meta.StringMap()
+		}
+		s["regions"] = regions
+	}
+	answers := make([]map[string]interface{}, 0)
+	for _, a := range r.Answers {
+		m := answerToMap(*a)
+		if meta, ok := m["meta"]; ok && len(meta.(map[string]interface{})) == 0 {
+			delete(m, "meta")
+		}
+		if len(m) == 1 {
+			// Only the RDATA, which is already in the zone file.
+			continue
+		}
+		answers = append(answers, m)
+	}
+	if len(answers) > 0 {
+		s["answers"] = answers
+	}
+	if len(s) == 0 {
+		return "", nil
+	}
+
+	out, err := json.Marshal(s)
+	if err != nil {
+		return "", fmt.Errorf("could not encode metadata of %s: %s", r, err)
+	}
+	return string(out), nil
+}
+
+// zoneFileRdata formats the RDATA fields of an answer for a zone file.
+func zoneFileRdata(t string, rdata []string) string {
+	fields := make([]string, len(rdata))
+	copy(fields, rdata)
+	switch t {
+	case "TXT", "SPF":
+		for i, f := range fields {
+			fields[i] = `"` + strings.Replace(strings.Replace(f, `\`, `\\`, -1), `"`, `\"`, -1) + `"`
+		}
+	default:
+		for _, i := range zoneFileNameFields[t] {
+			if i < len(fields) {
+				fields[i] = fqdn(fields[i])
+			}
+		}
+	}
+	return strings.Join(fields, " ")
+}
+
+// hostmasterToRName converts a hostmaster mailbox into an SOA RNAME, escaping
+// dots in the local part.
+func hostmasterToRName(hostmaster string) string {
+	parts := strings.SplitN(hostmaster, "@", 2)
+	if len(parts) != 2 {
+		return fqdn(hostmaster)
+	}
+	return fqdn(strings.Replace(parts[0], ".", `\.`, -1) + "." + parts[1])
+}
+
+// fqdn returns name with a trailing dot.
+func fqdn(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "."
+}
@@ -0,0 +1,120 @@
+package ns1
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+
+	"gopkg.in/ns1/ns1-go.v2/rest/model/data"
+	"gopkg.in/ns1/ns1-go.v2/rest/model/dns"
+	"gopkg.in/ns1/ns1-go.v2/rest/model/filter"
+)
+
+func TestAccDataSourceZoneFile_basic(t *testing.T) {
+	dataSourceName := "data.ns1_zone_file.test"
+	zoneName := fmt.Sprintf(
+		"terraform-test-%s.io",
+		acctest.RandStringFromCharSet(15, acctest.CharSetAlphaNum),
+	)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceZoneFileBasic(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "zone", zoneName),
+					resource.TestMatchResourceAttr(dataSourceName, "zone_file",
+						regexp.MustCompile(fmt.Sprintf(`(?m)^\$ORIGIN %s\.$`, regexp.QuoteMeta(zoneName)))),
+					resource.TestMatchResourceAttr(dataSourceName, "zone_file",
+						regexp.MustCompile(fmt.Sprintf(`(?m)^www\.%s\. 60 IN A 1\.2\.3\.4$`, regexp.QuoteMeta(zoneName)))),
+				),
+			},
+		},
+	})
+}
+
+func TestRenderZoneFile(t *testing.T) {
+	z := &dns.Zone{
+		Zone:       "example.io",
+		DNSServers: []string{"dns1.p01.nsone.net", "dns2.p01.nsone.net"},
+		Hostmaster: "host.master@nsone.net",
+		TTL:        3600,
+		Serial:     1234,
+		Refresh:    43200,
+		Retry:      7200,
+		Expiry:     1209600,
+		NxTTL:      3600,
+	}
+
+	www := dns.NewRecord("example.io", "www", "A")
+	www.TTL = 60
+	www.AddAnswer(dns.NewAv4Answer("1.2.3.4"))
+	www.AddFilter(filter.NewUp())
+	www.Meta = &data.Meta{}
+
+	mx := dns.NewRecord("example.io", "example.io", "MX")
+	mx.TTL = 300
+	mx.AddAnswer(dns.NewMXAnswer(10, "mail.example.io"))
+	mx.Meta = &data.Meta{}
+
+	txt := dns.NewRecord("example.io", "example.io", "TXT")
+	txt.TTL = 300
+	txt.AddAnswer(dns.NewTXTAnswer(`v=spf1 "quoted"`))
+
+	link := dns.NewRecord("example.io", "api", "CNAME")
+	link.TTL = 60
+	link.LinkTo("www.example.io")
+
+	got, err := renderZoneFile(z, []*dns.Record{www, txt, link, mx})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := `$ORIGIN example.io.
+$TTL 3600
+@ 3600 IN SOA dns1.p01.nsone.net. host\.master.nsone.net. (
+	1234 ; serial
+	43200 ; refresh
+	7200 ; retry
+	1209600 ; expiry
+	3600 ; nx_ttl
+)
+; api.example.io. 60 IN CNAME linked to www.example.io
+example.io. 300 IN MX 10 mail.example.io.
+example.io. 300 IN TXT "v=spf1 \"quoted\""
+www.example.io. 60 IN A 1.2.3.4
+;
+; NS1 steering metadata
+; www.example.io. A {"filters":[{"config":{},"disabled":false,"filter":"up"}]}
+`
+	if got != expected {
+		t.Fatalf("zone file mismatch:\ngot:\n%s\nwant:\n%s", got, expected)
+	}
+}
+
+func testAccDataSourceZoneFileBasic(zoneName string) string {
+	return fmt.Sprintf(`resource "ns1_zone" "it" {
+  zone = "%s"
+}
+
+resource "ns1_record" "www" {
+  zone   = "${ns1_zone.it.zone}"
+  domain = "www.${ns1_zone.it.zone}"
+  type   = "A"
+  ttl    = 60
+
+  answers {
+    answer = "1.2.3.4"
+  }
+}
+
+data "ns1_zone_file" "test" {
+  zone = "${ns1_record.www.zone}"
+}
+`, zoneName)
+}
@@ -33,8 +33,33 @@ func TestAccDataSourceZone_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(dataSourceName, "expiry", "1209600"),
 					resource.TestCheckResourceAttr(dataSourceName, "nx_ttl", "3600"),
 					resource.TestCheckResourceAttr(dataSourceName, "primary", "1.1.1.1"),
-					resource.TestCheckResourceAttr(dataSourceName, "additional_primaries.0", "2.2.2.2"),
-					resource.TestCheckResourceAttr(dataSourceName, "additional_primaries.1", "3.3.3.3"),
+					resource.TestCheckResourceAttr(dataSourceName, "primary_port", "53"),
+					resource.TestCheckResourceAttr(dataSourceName, "additional_primaries.0.ip", "2.2.2.2"),
+					resource.TestCheckResourceAttr(dataSourceName, "additional_primaries.0.port", "53"),
+					resource.TestCheckResourceAttr(dataSourceName, "additional_primaries.1.ip", "3.3.3.3"),
+					resource.TestCheckResourceAttr(dataSourceName, "additional_primaries.1.port", "5353"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceZone_records(t *testing.T) {
+	dataSourceName := "data.ns1_zone.test"
+	zoneName := fmt.Sprintf(
+		"terraform-test-%s.io",
+		acctest.RandStringFromCharSet(15, acctest.CharSetAlphaNum),
+	)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceZoneRecords(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					// The autogenerated apex NS record, and www.
+					resource.TestCheckResourceAttr(dataSourceName, "records.#", "2"),
 				),
 			},
 		},
@@ -45,7 +70,15 @@ func testAccDataSourceZoneBasic(zoneName string) string {
 	return fmt.Sprintf(`resource "ns1_zone" "it" {
   zone = "%s"
   primary = "1.1.1.1"
-  additional_primaries = ["2.2.2.2", "3.3.3.3"]
+
+  additional_primaries {
+    ip = "2.2.2.2"
+  }
+
+  additional_primaries {
+    ip   = "3.3.3.3"
+    port = 5353
+  }
 }
 
 data "ns1_zone" "test" {
@@ -53,3 +86,13 @@ data "ns1_zone" "test" {
 }
 `, zoneName)
 }
+
+func testAccDataSourceZoneRecords(zoneName string) string {
+	return testAccZoneWithRecord(zoneName) + `
+data "ns1_zone" "test" {
+  zone = "${ns1_zone.it.zone}"
+
+  depends_on = ["ns1_record.www"]
+}
+`
+}
@@ -0,0 +1,210 @@
+package ns1
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"gopkg.in/ns1/ns1-go.v2/rest/model/dns"
+)
+
+func dataSourceZones() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRegexp,
+			},
+			"primary": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"secondary": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"linked": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"networks": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"zones": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"link": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"primary": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"secondary": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"secondary_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"networks": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeInt},
+						},
+						"dns_servers": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+		Read: dataSourceZonesRead,
+	}
+}
+
+// zoneFilter selects zones of an account. Zero values match everything.
+type zoneFilter struct {
+	Name      *regexp.Regexp
+	Primary   *bool
+	Secondary *bool
+	Linked    *bool
+	Networks  []int
+}
+
+// zoneIsSecondary reports whether z is transferred from a primary.
+func zoneIsSecondary(z *dns.Zone) bool {
+	return z.Secondary != nil && z.Secondary.Enabled
+}
+
+// zoneIsLinked reports whether z serves the records of another zone.
+func zoneIsLinked(z *dns.Zone) bool {
+	return z.Link != nil && *z.Link != ""
+}
+
+// zoneIsPrimary reports whether z holds its own records, that is whether it
+// is neither a secondary nor a linked zone.
+func zoneIsPrimary(z *dns.Zone) bool {
+	return !zoneIsSecondary(z) && !zoneIsLinked(z)
+}
+
+func (f zoneFilter) match(z *dns.Zone) bool {
+	if f.Name != nil && !f.Name.MatchString(z.Zone) {
+		return false
+	}
+	if f.Primary != nil && zoneIsPrimary(z) != *f.Primary {
+		return false
+	}
+	if f.Secondary != nil && zoneIsSecondary(z) != *f.Secondary {
+		return false
+	}
+	if f.Linked != nil && zoneIsLinked(z) != *f.Linked {
+		return false
+	}
+	if len(f.Networks) > 0 {
+		found := false
+		for _, want := range f.Networks {
+			for _, id := range z.NetworkIDs {
+				if id == want {
+					found = true
+				}
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func zonesToMaps(zones []*dns.Zone, f zoneFilter) []map[string]interface{} {
+	out := make([]map[string]interface{}, 0, len(zones))
+	for _, z := range zones {
+		if !f.match(z) {
+			continue
+		}
+		m := map[string]interface{}{
+			"id":          z.ID,
+			"zone":        z.Zone,
+			"ttl":         z.TTL,
+			"primary":     zoneIsPrimary(z),
+			"secondary":   zoneIsSecondary(z),
+			"networks":    z.NetworkIDs,
+			"dns_servers": z.DNSServers,
+		}
+		if z.Link != nil {
+			m["link"] = *z.Link
+		}
+		if z.Secondary != nil {
+			m["secondary_status"] = z.Secondary.Status
+		}
+		out = append(out, m)
+	}
+	return out
+}
+
+func dataSourceZonesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+	zones, _, err := client.Zones.List()
+	if err != nil {
+		return err
+	}
+
+	f := zoneFilter{}
+	if v, ok := d.GetOk("name_regex"); ok {
+		f.Name = regexp.MustCompile(v.(string))
+	}
+	if v, ok := d.GetOkExists("primary"); ok {
+		primary := v.(bool)
+		f.Primary = &primary
+	}
+	if v, ok := d.GetOkExists("secondary"); ok {
+		secondary := v.(bool)
+		f.Secondary = &secondary
+	}
+	if v, ok := d.GetOkExists("linked"); ok {
+		linked := v.(bool)
+		f.Linked = &linked
+	}
+	if v, ok := d.GetOk("networks"); ok {
+		for _, id := range v.(*schema.Set).List() {
+			f.Networks = append(f.Networks, id.(int))
+		}
+	}
+
+	matched := zonesToMaps(zones, f)
+	ids := make([]string, len(matched))
+	for i, z := range matched {
+		ids[i] = z["id"].(string)
+	}
+	d.SetId(strconv.Itoa(hashcode.String(strings.Join(ids, ","))))
+	if err := d.Set("zones", matched); err != nil {
+		return fmt.Errorf("[DEBUG] Error setting zones, error: %#v", err)
+	}
+	return nil
+}
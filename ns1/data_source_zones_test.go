@@ -0,0 +1,79 @@
+package ns1
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+
+	"gopkg.in/ns1/ns1-go.v2/rest/model/dns"
+)
+
+func TestAccDataSourceZones_basic(t *testing.T) {
+	dataSourceName := "data.ns1_zones.test"
+	rString := acctest.RandStringFromCharSet(15, acctest.CharSetAlphaNum)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceZonesBasic(rString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "zones.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "zones.0.zone", fmt.Sprintf("terraform-test-%s-secondary.io", rString)),
+					resource.TestCheckResourceAttr(dataSourceName, "zones.0.secondary", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestZoneFilter(t *testing.T) {
+	link := "a.io"
+	zones := []*dns.Zone{
+		{Zone: "a.io", NetworkIDs: []int{0}, Primary: &dns.ZonePrimary{Enabled: false}},
+		{Zone: "b.io", NetworkIDs: []int{0}, Secondary: &dns.ZoneSecondary{Enabled: true}},
+		{Zone: "c.io", NetworkIDs: []int{1}, Link: &link},
+	}
+	yes, no := true, false
+	cases := []struct {
+		filter   zoneFilter
+		expected int
+	}{
+		{zoneFilter{}, 3},
+		{zoneFilter{Name: regexp.MustCompile(`^[ab]\.`)}, 2},
+		{zoneFilter{Primary: &yes}, 1},
+		{zoneFilter{Primary: &no}, 2},
+		{zoneFilter{Secondary: &yes}, 1},
+		{zoneFilter{Secondary: &no, Linked: &no}, 1},
+		{zoneFilter{Linked: &yes}, 1},
+		{zoneFilter{Networks: []int{1}}, 1},
+	}
+	for i, c := range cases {
+		if got := len(zonesToMaps(zones, c.filter)); got != c.expected {
+			t.Errorf("case %d: got %d zones, want %d", i, got, c.expected)
+		}
+	}
+}
+
+func testAccDataSourceZonesBasic(rString string) string {
+	return fmt.Sprintf(`resource "ns1_zone" "primary" {
+  zone = "terraform-test-%[1]s-primary.io"
+}
+
+resource "ns1_zone" "secondary" {
+  zone    = "terraform-test-%[1]s-secondary.io"
+  primary = "1.1.1.1"
+}
+
+data "ns1_zones" "test" {
+  name_regex = "^terraform-test-%[1]s-"
+  secondary  = true
+
+  depends_on = ["ns1_zone.primary", "ns1_zone.secondary"]
+}
+`, rString)
+}
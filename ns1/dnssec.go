@@ -0,0 +1,170 @@
+package ns1
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"strconv"
+	"strings"
+
+	ns1 "gopkg.in/ns1/ns1-go.v2/rest"
+	"gopkg.in/ns1/ns1-go.v2/rest/model/dns"
+)
+
+// zoneDNSSECStatus wraps the "dnssec" attribute of a zone, which the vendored
+// dns.Zone does not model.
+type zoneDNSSECStatus struct {
+	DNSSEC *bool `json:"dnssec,omitempty"`
+}
+
+// zoneDNSSEC wraps an NS1 /zones/{zone}/dnssec resource.
+type zoneDNSSEC struct {
+	Zone       string               `json:"zone,omitempty"`
+	Keys       *zoneDNSSECKeys      `json:"dnskey,omitempty"`
+	Delegation *zoneDNSSECDelegated `json:"delegation,omitempty"`
+}
+
+// zoneDNSSECKeys is the DNSKEY RRset NS1 signs the zone with.
+type zoneDNSSECKeys struct {
+	Keys []*dnskey `json:"data,omitempty"`
+	TTL  int       `json:"ttl,omitempty"`
+}
+
+// zoneDNSSECDelegated holds the keys the parent zone should delegate to.
+type zoneDNSSECDelegated struct {
+	Keys []*dnskey `json:"dnskey,omitempty"`
+	TTL  int       `json:"ttl,omitempty"`
+}
+
+// dnskey is the RDATA of a DNSKEY record, see RFC 4034 section 2.
+type dnskey struct {
+	Flags     json.Number `json:"flags"`
+	Protocol  json.Number `json:"protocol"`
+	Algorithm json.Number `json:"algorithm"`
+	PublicKey string      `json:"public_key"`
+}
+
+// dsDigests maps the supported DS digest types to their hash function.
+var dsDigests = map[int]func() hash.Hash{
+	1: sha1.New,
+	2: sha256.New,
+	4: sha512.New384,
+}
+
+// zoneWithDNSSEC is a zone along with its "dnssec" attribute.
+type zoneWithDNSSEC struct {
+	dns.Zone
+	zoneDNSSECStatus
+}
+
+// getZoneWithDNSSEC reads a zone and whether DNSSEC is enabled on it.
+func getZoneWithDNSSEC(client *ns1.Client, zone string) (*dns.Zone, bool, error) {
+	var z zoneWithDNSSEC
+	if _, err := apiRequest(client, "GET", fmt.Sprintf("zones/%s", zone), nil, &z); err != nil {
+		return nil, false, err
+	}
+	return &z.Zone, z.DNSSEC != nil && *z.DNSSEC, nil
+}
+
+// setZoneDNSSECEnabled enables or disables DNSSEC on a zone.
+func setZoneDNSSECEnabled(client *ns1.Client, zone string, enabled bool) error {
+	status := zoneDNSSECStatus{DNSSEC: &enabled}
+	_, err := apiRequest(client, "POST", fmt.Sprintf("zones/%s", zone), &status, nil)
+	return err
+}
+
+// getZoneDNSSEC reads the DNSSEC keys of a zone.
+func getZoneDNSSEC(client *ns1.Client, zone string) (*zoneDNSSEC, error) {
+	var z zoneDNSSEC
+	if _, err := apiRequest(client, "GET", fmt.Sprintf("zones/%s/dnssec", zone), nil, &z); err != nil {
+		return nil, err
+	}
+	return &z, nil
+}
+
+// rdata returns the wire format RDATA of the key.
+func (k *dnskey) rdata() ([]byte, error) {
+	flags, err := strconv.ParseUint(k.Flags.String(), 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("invalid DNSKEY flags %q: %s", k.Flags, err)
+	}
+	protocol, err := strconv.ParseUint(k.Protocol.String(), 10, 8)
+	if err != nil {
+		return nil, fmt.Errorf("invalid DNSKEY protocol %q: %s", k.Protocol, err)
+	}
+	algorithm, err := strconv.ParseUint(k.Algorithm.String(), 10, 8)
+	if err != nil {
+		return nil, fmt.Errorf("invalid DNSKEY algorithm %q: %s", k.Algorithm, err)
+	}
+	publicKey, err := base64.StdEncoding.DecodeString(strings.Replace(k.PublicKey, " ", "", -1))
+	if err != nil {
+		return nil, fmt.Errorf("invalid DNSKEY public key: %s", err)
+	}
+
+	var b bytes.Buffer
+	binary.Write(&b, binary.BigEndian, uint16(flags))
+	b.WriteByte(byte(protocol))
+	b.WriteByte(byte(algorithm))
+	b.Write(publicKey)
+	return b.Bytes(), nil
+}
+
+// keyTag computes the key tag of the key, see RFC 4034 appendix B.
+func (k *dnskey) keyTag() (int, error) {
+	rdata, err := k.rdata()
+	if err != nil {
+		return 0, err
+	}
+	var ac uint32
+	for i, b := range rdata {
+		if i&1 == 1 {
+			ac += uint32(b)
+		} else {
+			ac += uint32(b) << 8
+		}
+	}
+	ac += ac >> 16 & 0xFFFF
+	return int(ac & 0xFFFF), nil
+}
+
+// ds computes the hex encoded digest of a DS record for the key, owned by
+// zone, see RFC 4034 section 5.1.4.
+func (k *dnskey) ds(zone string, digestType int) (string, error) {
+	newHash, ok := dsDigests[digestType]
+	if !ok {
+		return "", fmt.Errorf("unsupported DS digest type %d", digestType)
+	}
+	rdata, err := k.rdata()
+	if err != nil {
+		return "", err
+	}
+	owner, err := wireName(zone)
+	if err != nil {
+		return "", err
+	}
+	h := newHash()
+	h.Write(owner)
+	h.Write(rdata)
+	return strings.ToUpper(hex.EncodeToString(h.Sum(nil))), nil
+}
+
+// wireName returns the canonical wire format of a domain name.
+func wireName(name string) ([]byte, error) {
+	var b bytes.Buffer
+	for _, label := range strings.Split(strings.TrimSuffix(strings.ToLower(name), "."), ".") {
+		if len(label) == 0 || len(label) > 63 {
+			return nil, fmt.Errorf("invalid domain name %q", name)
+		}
+		b.WriteByte(byte(len(label)))
+		b.WriteString(label)
+	}
+	b.WriteByte(0)
+	return b.Bytes(), nil
+}
@@ -0,0 +1,168 @@
+package ns1
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	ns1 "gopkg.in/ns1/ns1-go.v2/rest"
+	"gopkg.in/ns1/ns1-go.v2/rest/model/dns"
+)
+
+// zoneLinkTargetSchema describes the SOA of the target of a linked zone.
+var zoneLinkTargetSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"hostmaster": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"serial": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"ttl": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"refresh": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"retry": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"expiry": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"nx_ttl": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+	},
+}
+
+// zoneLinkTargetToMaps returns the SOA of target, to set as the target_soa of
+// a linked zone.
+func zoneLinkTargetToMaps(target *dns.Zone) []map[string]interface{} {
+	return []map[string]interface{}{{
+		"hostmaster": target.Hostmaster,
+		"serial":     target.Serial,
+		"ttl":        target.TTL,
+		"refresh":    target.Refresh,
+		"retry":      target.Retry,
+		"expiry":     target.Expiry,
+		"nx_ttl":     target.NxTTL,
+	}}
+}
+
+// checkZoneLinkTarget checks that target can be linked to.
+func checkZoneLinkTarget(target *dns.Zone) error {
+	if target.Link != nil && *target.Link != "" {
+		return fmt.Errorf("link target %s is itself linked to %s, link to %s instead", target.Zone, *target.Link, *target.Link)
+	}
+	return nil
+}
+
+// checkRecordLinkTarget checks that target can be linked to by a record of
+// type t.
+func checkRecordLinkTarget(target *dns.Record, t string) error {
+	if target.Type != t {
+		return fmt.Errorf("link target %s is a %s record, it can't be linked to by a %s record", target.Domain, target.Type, t)
+	}
+	if target.Link != "" {
+		return fmt.Errorf("link target %s %s is itself linked to %s, link to %s instead", target.Domain, target.Type, target.Link, target.Link)
+	}
+	return nil
+}
+
+// domainSuffixes returns domain and each of its parent domains, longest first.
+func domainSuffixes(domain string) []string {
+	labels := strings.Split(strings.TrimSuffix(domain, "."), ".")
+	suffixes := make([]string, len(labels))
+	for i := range labels {
+		suffixes[i] = strings.Join(labels[i:], ".")
+	}
+	return suffixes
+}
+
+// getRecordLinkTarget reads the record of type t at domain, looking for the
+// zone holding it among the parent domains of domain. A record of another
+// type at domain is returned if there is none of type t, so that the caller
+// can report the mismatch. Returns ns1.ErrRecordMissing if there is no record
+// at domain at all.
+func getRecordLinkTarget(client *ns1.Client, domain, t string) (*dns.Record, error) {
+	for _, zone := range domainSuffixes(domain) {
+		z, _, err := client.Zones.Get(zone)
+		if err == ns1.ErrZoneMissing {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var found *dns.ZoneRecord
+		for _, zr := range z.Records {
+			if zr.Domain != strings.TrimSuffix(domain, ".") {
+				continue
+			}
+			if found == nil || zr.Type == t {
+				found = zr
+			}
+		}
+		if found == nil {
+			return nil, ns1.ErrRecordMissing
+		}
+		r, _, err := client.Records.Get(z.Zone, found.Domain, found.Type)
+		return r, err
+	}
+	return nil, ns1.ErrRecordMissing
+}
+
+// recordLinkTargetAnswers returns the answers of target as space delimited
+// RDATA strings.
+func recordLinkTargetAnswers(target *dns.Record) []string {
+	answers := make([]string, len(target.Answers))
+	for i, a := range target.Answers {
+		answers[i] = strings.Join(a.Rdata, " ")
+	}
+	return answers
+}
+
+// resourceZoneCustomizeDiffLink checks the target of a linked zone when the
+// link changes. A target that does not exist yet may be created in the same
+// apply, and is only reported when creating the zone.
+func resourceZoneCustomizeDiffLink(d *schema.ResourceDiff, meta interface{}) error {
+	link := d.Get("link").(string)
+	if link == "" || !d.HasChange("link") || !d.NewValueKnown("link") {
+		return nil
+	}
+	target, _, err := meta.(*providerMeta).client.Zones.Get(link)
+	if err == ns1.ErrZoneMissing {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("could not read link target %s: %s", link, err)
+	}
+	return checkZoneLinkTarget(target)
+}
+
+// recordCustomizeDiff checks the target of a linked record when the link
+// changes. A target that does not exist yet may be created in the same apply,
+// and is only reported when creating the record.
+func recordCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	link := d.Get("link").(string)
+	if link == "" || !d.HasChange("link") || !d.NewValueKnown("link") || !d.NewValueKnown("type") {
+		return nil
+	}
+	target, err := getRecordLinkTarget(meta.(*providerMeta).client, link, d.Get("type").(string))
+	if err == ns1.ErrRecordMissing {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("could not read link target %s: %s", link, err)
+	}
+	return checkRecordLinkTarget(target, d.Get("type").(string))
+}
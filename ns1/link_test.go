@@ -0,0 +1,43 @@
+package ns1
+
+import (
+	"reflect"
+	"testing"
+
+	"gopkg.in/ns1/ns1-go.v2/rest/model/dns"
+)
+
+func TestDomainSuffixes(t *testing.T) {
+	expected := []string{"www.example.co.uk", "example.co.uk", "co.uk", "uk"}
+	if got := domainSuffixes("www.example.co.uk."); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("got: %#v\nwant: %#v", got, expected)
+	}
+}
+
+func TestCheckZoneLinkTarget(t *testing.T) {
+	link := "c.io"
+	if err := checkZoneLinkTarget(&dns.Zone{Zone: "b.io"}); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+	if err := checkZoneLinkTarget(&dns.Zone{Zone: "b.io", Link: &link}); err == nil {
+		t.Errorf("expected an error for a linked target")
+	}
+}
+
+func TestCheckRecordLinkTarget(t *testing.T) {
+	cases := []struct {
+		target *dns.Record
+		t      string
+		valid  bool
+	}{
+		{&dns.Record{Domain: "www.a.io", Type: "A"}, "A", true},
+		{&dns.Record{Domain: "www.a.io", Type: "A"}, "CNAME", false},
+		{&dns.Record{Domain: "www.a.io", Type: "A", Link: "www.b.io"}, "A", false},
+	}
+	for i, c := range cases {
+		err := checkRecordLinkTarget(c.target, c.t)
+		if (err == nil) != c.valid {
+			t.Errorf("case %d: got error %v, want valid %t", i, err, c.valid)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package ns1
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Breaking schema changes are handled by bumping the SchemaVersion of a
+// resource, and adding a schema.StateUpgrader from the previous version:
+//
+//   - copy the previous schema in full, so that it doesn't change along with
+//     the current one;
+//   - write the function converting a state of the previous version, which
+//     receives and returns the JSON decoded state;
+//   - append stateUpgrader(previousVersion, previousSchema, upgradeFunc) to
+//     the StateUpgraders of the resource.
+//
+// Terraform runs the upgraders in order, from the version of a state up to
+// the current one.
+
+// stateUpgrader returns a schema.StateUpgrader from version, whose schema is
+// s, to the next version.
+func stateUpgrader(version int, s map[string]*schema.Schema, upgrade schema.StateUpgradeFunc) schema.StateUpgrader {
+	return schema.StateUpgrader{
+		Version: version,
+		Type:    (&schema.Resource{Schema: s}).CoreConfigSchema().ImpliedType(),
+		Upgrade: upgrade,
+	}
+}
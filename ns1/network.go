@@ -0,0 +1,64 @@
+package ns1
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	ns1 "gopkg.in/ns1/ns1-go.v2/rest"
+)
+
+// network wraps an NS1 /networks resource, which the vendored SDK does not
+// model.
+type network struct {
+	ID    int    `json:"network_id"`
+	Name  string `json:"name"`
+	Label string `json:"label"`
+}
+
+// listNetworks returns the networks available to the account.
+func listNetworks(client *ns1.Client) ([]*network, error) {
+	networks := make([]*network, 0)
+	if _, err := apiRequest(client, "GET", "networks", nil, &networks); err != nil {
+		return nil, err
+	}
+	return networks, nil
+}
+
+// checkNetworkIDs returns an error listing the IDs not found in networks.
+func checkNetworkIDs(key string, ids []int, networks []*network) error {
+	known := make(map[int]bool, len(networks))
+	for _, n := range networks {
+		known[n.ID] = true
+	}
+	unknown := make([]int, 0)
+	for _, id := range ids {
+		if !known[id] {
+			unknown = append(unknown, id)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Ints(unknown)
+		return fmt.Errorf("%s: unknown network IDs %v, see the ns1_networks data source for the networks of the account", key, unknown)
+	}
+	return nil
+}
+
+// resourceZoneCustomizeDiffNetworks checks that the networks of a zone exist
+// when they change.
+func resourceZoneCustomizeDiffNetworks(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("networks") || !d.NewValueKnown("networks") {
+		return nil
+	}
+	var ids []int
+	for _, id := range d.Get("networks").(*schema.Set).List() {
+		ids = append(ids, id.(int))
+	}
+
+	networks, err := listNetworks(meta.(*providerMeta).client)
+	if err != nil {
+		return fmt.Errorf("could not list networks: %s", err)
+	}
+	return checkNetworkIDs("networks", ids, networks)
+}
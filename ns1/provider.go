@@ -31,20 +31,40 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("NS1_IGNORE_SSL", nil),
 				Description: descriptions["ignore_ssl"],
 			},
+			"delete_protection_qps_threshold": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validateQPSThreshold,
+				Description:  descriptions["delete_protection_qps_threshold"],
+			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"ns1_zone": dataSourceZone(),
+			"ns1_zone":      dataSourceZone(),
+			"ns1_dnssec":    dataSourceDNSSEC(),
+			"ns1_record":    dataSourceRecord(),
+			"ns1_records":   dataSourceRecords(),
+			"ns1_zone_file": dataSourceZoneFile(),
+			"ns1_zones":     dataSourceZones(),
+			"ns1_networks":  dataSourceNetworks(),
+			"ns1_qps":       dataSourceQPS(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"ns1_zone":          resourceZone(),
-			"ns1_record":        recordResource(),
-			"ns1_datasource":    dataSourceResource(),
-			"ns1_datafeed":      dataFeedResource(),
-			"ns1_monitoringjob": monitoringJobResource(),
-			"ns1_notifylist":    notifyListResource(),
-			"ns1_user":          userResource(),
-			"ns1_apikey":        apikeyResource(),
-			"ns1_team":          teamResource(),
+			"ns1_zone":                 resourceZone(),
+			"ns1_record":               recordResource(),
+			"ns1_acl":                  resourceACL(),
+			"ns1_dnsview":              resourceDNSView(),
+			"ns1_tsig_key":             resourceTSIGKey(),
+			"ns1_redirect":             resourceRedirect(),
+			"ns1_redirect_certificate": resourceRedirectCertificate(),
+			"ns1_datasource":           dataSourceResource(),
+			"ns1_datafeed":             dataFeedResource(),
+			"ns1_monitoringjob":        monitoringJobResource(),
+			"ns1_notifylist":           notifyListResource(),
+			"ns1_user":                 userResource(),
+			"ns1_apikey":               apikeyResource(),
+			"ns1_team":                 teamResource(),
+			"ns1_account_settings":     resourceAccountSettings(),
+			"ns1_usage_warnings":       resourceUsageWarnings(),
 		},
 		ConfigureFunc: ns1Configure,
 	}
@@ -73,8 +93,19 @@ func ns1Configure(d *schema.ResourceData) (interface{}, error) {
 	if v, ok := d.GetOk("ignore_ssl"); ok {
 		config.IgnoreSSL = v.(bool)
 	}
+	if v, ok := d.GetOkExists("delete_protection_qps_threshold"); ok {
+		threshold := v.(float64)
+		config.RecordDeleteQPSThreshold = &threshold
+	}
 
-	return config.Client()
+	client, err := config.Client()
+	if err != nil {
+		return nil, err
+	}
+	return &providerMeta{
+		client:                   client,
+		recordDeleteQPSThreshold: config.RecordDeleteQPSThreshold,
+	}, nil
 }
 
 var descriptions map[string]string
@@ -82,6 +113,8 @@ var descriptions map[string]string
 func init() {
 	descriptions = map[string]string{
 		"api_key": "The ns1 API key, this is required",
+		"delete_protection_qps_threshold": "Refuse to delete records serving more queries per second than this, " +
+			"unless overridden on the record",
 	}
 
 	structs.DefaultTagName = "json"
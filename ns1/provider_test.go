@@ -2,10 +2,13 @@ package ns1
 
 import (
 	"os"
+	"strconv"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+
+	ns1 "gopkg.in/ns1/ns1-go.v2/rest"
 )
 
 var testAccProviders map[string]terraform.ResourceProvider
@@ -33,3 +36,19 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("NS1_APIKEY must be set for acceptance tests")
 	}
 }
+
+// testAccClient returns a client configured from the environment, for tests
+// which need the API before the provider is configured.
+func testAccClient(t *testing.T) *ns1.Client {
+	ignoreSSL, _ := strconv.ParseBool(os.Getenv("NS1_IGNORE_SSL"))
+	config := Config{
+		Key:       os.Getenv("NS1_APIKEY"),
+		Endpoint:  os.Getenv("NS1_ENDPOINT"),
+		IgnoreSSL: ignoreSSL,
+	}
+	client, err := config.Client()
+	if err != nil {
+		t.Fatalf("could not configure client: %s", err)
+	}
+	return client
+}
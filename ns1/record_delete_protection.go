@@ -0,0 +1,60 @@
+package ns1
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	ns1 "gopkg.in/ns1/ns1-go.v2/rest"
+)
+
+// recordDeleteQPSThreshold returns the delete_protection_qps_threshold of a
+// record, falling back to the provider level one. ok is false when neither is
+// set.
+func recordDeleteQPSThreshold(d *schema.ResourceData, meta *providerMeta) (threshold float64, ok bool) {
+	if v, ok := d.GetOkExists("delete_protection_qps_threshold"); ok {
+		return v.(float64), true
+	}
+	if meta.recordDeleteQPSThreshold == nil {
+		return 0, false
+	}
+	return *meta.recordDeleteQPSThreshold, true
+}
+
+// checkRecordQPS returns an error when qps is above threshold.
+func checkRecordQPS(zone, domain, t string, qps, threshold float64) error {
+	if qps > threshold {
+		return fmt.Errorf(
+			"record %s %s in zone %s is still serving %g QPS, above delete_protection_qps_threshold (%g), refusing to delete it",
+			domain, t, zone, qps, threshold,
+		)
+	}
+	return nil
+}
+
+// checkRecordDeleteProtection refuses to delete a record still serving more
+// queries than its delete_protection_qps_threshold.
+func checkRecordDeleteProtection(d *schema.ResourceData, meta *providerMeta) error {
+	threshold, ok := recordDeleteQPSThreshold(d, meta)
+	if !ok {
+		return nil
+	}
+	zone, domain, t := d.Get("zone").(string), d.Get("domain").(string), d.Get("type").(string)
+	qps, err := getQPS(meta.client, zone, domain, t)
+	if err == ns1.ErrRecordMissing || err == ns1.ErrZoneMissing {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("could not read QPS of record %s %s, needed by delete_protection_qps_threshold: %s", domain, t, err)
+	}
+	return checkRecordQPS(zone, domain, t, qps, threshold)
+}
+
+// validateQPSThreshold (schema helper) checks that a QPS threshold is not
+// negative.
+func validateQPSThreshold(v interface{}, k string) (ws []string, es []error) {
+	if value := v.(float64); value < 0 {
+		es = append(es, fmt.Errorf("%q must not be negative, got %g", k, value))
+	}
+	return
+}
@@ -0,0 +1,52 @@
+package ns1
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	ns1 "gopkg.in/ns1/ns1-go.v2/rest"
+)
+
+func TestCheckRecordQPS(t *testing.T) {
+	cases := []struct {
+		qps, threshold float64
+		valid          bool
+	}{
+		{0, 0, true},
+		{0.5, 1, true},
+		{1, 1, true},
+		{1.5, 1, false},
+		{0.1, 0, false},
+	}
+	for _, c := range cases {
+		err := checkRecordQPS("a.io", "www.a.io", "A", c.qps, c.threshold)
+		if (err == nil) != c.valid {
+			t.Errorf("%g QPS, threshold %g: got error %v, want valid %t", c.qps, c.threshold, err, c.valid)
+		}
+	}
+}
+
+func TestRecordDeleteQPSThreshold(t *testing.T) {
+	meta := &providerMeta{client: &ns1.Client{}}
+	unset := schema.TestResourceDataRaw(t, recordResource().Schema, map[string]interface{}{})
+	set := schema.TestResourceDataRaw(t, recordResource().Schema, map[string]interface{}{
+		"delete_protection_qps_threshold": 5.0,
+	})
+
+	if _, ok := recordDeleteQPSThreshold(unset, meta); ok {
+		t.Fatalf("expected no threshold")
+	}
+	if threshold, ok := recordDeleteQPSThreshold(set, meta); !ok || threshold != 5 {
+		t.Fatalf("got threshold %g (%t), want 5", threshold, ok)
+	}
+
+	threshold := 10.0
+	meta.recordDeleteQPSThreshold = &threshold
+	if threshold, ok := recordDeleteQPSThreshold(unset, meta); !ok || threshold != 10 {
+		t.Fatalf("got provider threshold %g (%t), want 10", threshold, ok)
+	}
+	if threshold, ok := recordDeleteQPSThreshold(set, meta); !ok || threshold != 5 {
+		t.Fatalf("got record threshold %g (%t), want 5", threshold, ok)
+	}
+}
@@ -0,0 +1,181 @@
+package ns1
+
+import (
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"gopkg.in/ns1/ns1-go.v2/rest/model/account"
+)
+
+// resourceAccountSettings manages the settings of the account of the API key.
+// There is a single instance per account: creating the resource adopts the
+// existing settings, and destroying it only removes it from the state.
+func resourceAccountSettings() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			// Optional, fields not set are left as they are.
+			"first_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"last_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"company": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"phone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"address": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"street": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"city": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"postal_code": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"country": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			// Computed
+			"customer_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+		Create:   resourceAccountSettingsCreate,
+		Read:     resourceAccountSettingsRead,
+		Update:   resourceAccountSettingsUpdate,
+		Delete:   resourceAccountSettingsDelete,
+		Importer: &schema.ResourceImporter{State: schema.ImportStatePassthrough},
+	}
+}
+
+func accountSettingsToResourceData(d *schema.ResourceData, s *account.Setting) {
+	d.SetId(strconv.Itoa(s.CustomerID))
+	d.Set("customer_id", s.CustomerID)
+	d.Set("first_name", s.FirstName)
+	d.Set("last_name", s.LastName)
+	d.Set("company", s.Company)
+	d.Set("phone", s.Phone)
+	d.Set("email", s.Email)
+	d.Set("address", []map[string]interface{}{{
+		"street":      s.Address.Street,
+		"city":        s.Address.City,
+		"state":       s.Address.State,
+		"postal_code": s.Address.Postal,
+		"country":     s.Address.Country,
+	}})
+}
+
+// resourceDataToAccountSettings overlays the configured settings on s.
+func resourceDataToAccountSettings(s *account.Setting, d *schema.ResourceData) {
+	if v, ok := d.GetOk("first_name"); ok {
+		s.FirstName = v.(string)
+	}
+	if v, ok := d.GetOk("last_name"); ok {
+		s.LastName = v.(string)
+	}
+	if v, ok := d.GetOk("company"); ok {
+		s.Company = v.(string)
+	}
+	if v, ok := d.GetOk("phone"); ok {
+		s.Phone = v.(string)
+	}
+	if v, ok := d.GetOk("email"); ok {
+		s.Email = v.(string)
+	}
+	if v, ok := d.GetOk("address.0.street"); ok {
+		s.Address.Street = v.(string)
+	}
+	if v, ok := d.GetOk("address.0.city"); ok {
+		s.Address.City = v.(string)
+	}
+	if v, ok := d.GetOk("address.0.state"); ok {
+		s.Address.State = v.(string)
+	}
+	if v, ok := d.GetOk("address.0.postal_code"); ok {
+		s.Address.Postal = v.(string)
+	}
+	if v, ok := d.GetOk("address.0.country"); ok {
+		s.Address.Country = v.(string)
+	}
+}
+
+// resourceAccountSettingsCreate adopts the settings of the account, and
+// writes the configured ones
+func resourceAccountSettingsCreate(d *schema.ResourceData, meta interface{}) error {
+	return resourceAccountSettingsUpdate(d, meta)
+}
+
+// resourceAccountSettingsRead reads the settings of the account from ns1
+func resourceAccountSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+	s, _, err := client.Settings.Get()
+	if err != nil {
+		return err
+	}
+	accountSettingsToResourceData(d, s)
+	return nil
+}
+
+// resourceAccountSettingsUpdate writes the configured settings of the account
+// in ns1
+func resourceAccountSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+	s, _, err := client.Settings.Get()
+	if err != nil {
+		return err
+	}
+	resourceDataToAccountSettings(s, d)
+	if _, err := client.Settings.Update(s); err != nil {
+		return err
+	}
+	accountSettingsToResourceData(d, s)
+	return nil
+}
+
+// resourceAccountSettingsDelete removes the settings from the state, they are
+// left unchanged in ns1
+func resourceAccountSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,33 @@
+package ns1
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// Account settings are shared by every test of the account, so this only
+// adopts them without changes.
+func TestAccAccountSettings_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountSettingsBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ns1_account_settings.it", "customer_id"),
+					resource.TestCheckResourceAttrSet("ns1_account_settings.it", "email"),
+				),
+			},
+			{
+				ResourceName:      "ns1_account_settings.it",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccAccountSettingsBasic = `resource "ns1_account_settings" "it" {}
+`
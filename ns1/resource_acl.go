@@ -0,0 +1,116 @@
+package ns1
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// acl wraps an NS1 /acls resource, which the vendored SDK does not model.
+type acl struct {
+	Name              string   `json:"acl_name"`
+	SrcPrefixes       []string `json:"src_prefixes"`
+	TSIGKeys          []string `json:"tsig_keys"`
+	GSSTSIGIdentities []string `json:"gss_tsig_identities"`
+}
+
+func resourceACL() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			// Required
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// Optional
+			"src_prefixes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateCIDR,
+				},
+			},
+			"tsig_keys": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"gss_tsig_identities": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		Create:   resourceACLCreate,
+		Read:     resourceACLRead,
+		Update:   resourceACLUpdate,
+		Delete:   resourceACLDelete,
+		Importer: &schema.ResourceImporter{State: schema.ImportStatePassthrough},
+	}
+}
+
+func aclToResourceData(d *schema.ResourceData, a *acl) {
+	d.SetId(a.Name)
+	d.Set("name", a.Name)
+	d.Set("src_prefixes", a.SrcPrefixes)
+	d.Set("tsig_keys", a.TSIGKeys)
+	d.Set("gss_tsig_identities", a.GSSTSIGIdentities)
+}
+
+func resourceDataToACL(d *schema.ResourceData) *acl {
+	return &acl{
+		Name:              d.Get("name").(string),
+		SrcPrefixes:       setToStrings(d.Get("src_prefixes").(*schema.Set)),
+		TSIGKeys:          setToStrings(d.Get("tsig_keys").(*schema.Set)),
+		GSSTSIGIdentities: setToStrings(d.Get("gss_tsig_identities").(*schema.Set)),
+	}
+}
+
+// resourceACLCreate creates the given ACL in ns1
+func resourceACLCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+	a := resourceDataToACL(d)
+	if _, err := apiRequest(client, "PUT", fmt.Sprintf("acls/%s", a.Name), a, a); err != nil {
+		return err
+	}
+	aclToResourceData(d, a)
+	return nil
+}
+
+// resourceACLRead reads the given ACL from ns1
+func resourceACLRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+	var a acl
+	if _, err := apiRequest(client, "GET", fmt.Sprintf("acls/%s", d.Id()), nil, &a); err != nil {
+		if apiNotFound(err) {
+			log.Printf("[WARN] ACL %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	aclToResourceData(d, &a)
+	return nil
+}
+
+// resourceACLUpdate updates the given ACL in ns1
+func resourceACLUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+	a := resourceDataToACL(d)
+	if _, err := apiRequest(client, "POST", fmt.Sprintf("acls/%s", a.Name), a, a); err != nil {
+		return err
+	}
+	aclToResourceData(d, a)
+	return nil
+}
+
+// resourceACLDelete deletes the given ACL from ns1
+func resourceACLDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+	_, err := apiRequest(client, "DELETE", fmt.Sprintf("acls/%s", d.Id()), nil, nil)
+	d.SetId("")
+	return err
+}
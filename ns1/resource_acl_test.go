@@ -0,0 +1,106 @@
+package ns1
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccACL_basic(t *testing.T) {
+	name := fmt.Sprintf("terraform-test-%s", acctest.RandStringFromCharSet(15, acctest.CharSetAlphaNum))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckACLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccACLBasic(name, `"10.0.0.0/8"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckACLExists("ns1_acl.office"),
+					resource.TestCheckResourceAttr("ns1_acl.office", "name", name),
+					resource.TestCheckResourceAttr("ns1_acl.office", "src_prefixes.#", "1"),
+				),
+			},
+			{
+				Config: testAccACLBasic(name, `"10.0.0.0/8", "192.168.0.0/16"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckACLExists("ns1_acl.office"),
+					resource.TestCheckResourceAttr("ns1_acl.office", "src_prefixes.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "ns1_acl.office",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestValidateCIDR(t *testing.T) {
+	cases := []struct {
+		value string
+		valid bool
+	}{
+		{"10.0.0.0/8", true},
+		{"2001:db8::/32", true},
+		{"10.0.0.1", false},
+		{"office", false},
+	}
+	for _, c := range cases {
+		_, es := validateCIDR(c.value, "src_prefixes")
+		if (len(es) == 0) != c.valid {
+			t.Errorf("%s: got errors %v, want valid %t", c.value, es, c.valid)
+		}
+	}
+}
+
+func testAccCheckACLExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("NoID is set")
+		}
+
+		client := testAccProvider.Meta().(*providerMeta).client
+		var a acl
+		if _, err := apiRequest(client, "GET", fmt.Sprintf("acls/%s", rs.Primary.ID), nil, &a); err != nil {
+			return err
+		}
+		if a.Name != rs.Primary.ID {
+			return fmt.Errorf("ACL not found")
+		}
+		return nil
+	}
+}
+
+func testAccCheckACLDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*providerMeta).client
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ns1_acl" {
+			continue
+		}
+		_, err := apiRequest(client, "GET", fmt.Sprintf("acls/%s", rs.Primary.ID), nil, &acl{})
+		if err == nil {
+			return fmt.Errorf("ACL still exists: %s", rs.Primary.ID)
+		}
+		if !apiNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccACLBasic(name, prefixes string) string {
+	return fmt.Sprintf(`resource "ns1_acl" "office" {
+  name         = "%s"
+  src_prefixes = [%s]
+}
+`, name, prefixes)
+}
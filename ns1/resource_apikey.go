@@ -3,7 +3,6 @@ package ns1
 import (
 	"github.com/hashicorp/terraform/helper/schema"
 
-	ns1 "gopkg.in/ns1/ns1-go.v2/rest"
 	"gopkg.in/ns1/ns1-go.v2/rest/model/account"
 )
 
@@ -60,7 +59,7 @@ func resourceDataToApikey(k *account.APIKey, d *schema.ResourceData) error {
 
 // ApikeyCreate creates ns1 API key
 func ApikeyCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ns1.Client)
+	client := meta.(*providerMeta).client
 	k := account.APIKey{}
 	if err := resourceDataToApikey(&k, d); err != nil {
 		return err
@@ -73,7 +72,7 @@ func ApikeyCreate(d *schema.ResourceData, meta interface{}) error {
 
 // ApikeyRead reads API key from ns1
 func ApikeyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ns1.Client)
+	client := meta.(*providerMeta).client
 	k, _, err := client.APIKeys.Get(d.Id())
 	if err != nil {
 		return err
@@ -83,7 +82,7 @@ func ApikeyRead(d *schema.ResourceData, meta interface{}) error {
 
 //ApikeyDelete deletes the given ns1 api key
 func ApikeyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ns1.Client)
+	client := meta.(*providerMeta).client
 	_, err := client.APIKeys.Delete(d.Id())
 	d.SetId("")
 	return err
@@ -91,7 +90,7 @@ func ApikeyDelete(d *schema.ResourceData, meta interface{}) error {
 
 //ApikeyUpdate updates the given api key in ns1
 func ApikeyUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ns1.Client)
+	client := meta.(*providerMeta).client
 	k := account.APIKey{
 		ID: d.Id(),
 	}
@@ -3,7 +3,6 @@ package ns1
 import (
 	"github.com/hashicorp/terraform/helper/schema"
 
-	ns1 "gopkg.in/ns1/ns1-go.v2/rest"
 	"gopkg.in/ns1/ns1-go.v2/rest/model/data"
 )
 
@@ -47,7 +46,7 @@ func resourceDataToDataFeed(d *schema.ResourceData) *data.Feed {
 
 // DataFeedCreate creates an ns1 datafeed
 func DataFeedCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ns1.Client)
+	client := meta.(*providerMeta).client
 	f := resourceDataToDataFeed(d)
 	if _, err := client.DataFeeds.Create(d.Get("source_id").(string), f); err != nil {
 		return err
@@ -58,7 +57,7 @@ func DataFeedCreate(d *schema.ResourceData, meta interface{}) error {
 
 // DataFeedRead reads the datafeed for the given ID from ns1
 func DataFeedRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ns1.Client)
+	client := meta.(*providerMeta).client
 	f, _, err := client.DataFeeds.Get(d.Get("source_id").(string), d.Id())
 	if err != nil {
 		return err
@@ -69,7 +68,7 @@ func DataFeedRead(d *schema.ResourceData, meta interface{}) error {
 
 // DataFeedDelete delets the given datafeed from ns1
 func DataFeedDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ns1.Client)
+	client := meta.(*providerMeta).client
 	_, err := client.DataFeeds.Delete(d.Get("source_id").(string), d.Id())
 	d.SetId("")
 	return err
@@ -77,7 +76,7 @@ func DataFeedDelete(d *schema.ResourceData, meta interface{}) error {
 
 // DataFeedUpdate updates the given datafeed with modified parameters
 func DataFeedUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ns1.Client)
+	client := meta.(*providerMeta).client
 	f := resourceDataToDataFeed(d)
 	f.ID = d.Id()
 	if _, err := client.DataFeeds.Update(d.Get("source_id").(string), f); err != nil {
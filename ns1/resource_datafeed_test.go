@@ -7,7 +7,6 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 
-	ns1 "gopkg.in/ns1/ns1-go.v2/rest"
 	"gopkg.in/ns1/ns1-go.v2/rest/model/data"
 )
 
@@ -74,7 +73,7 @@ func testAccCheckDataFeedExists(n string, dsrc string, dataFeed *data.Feed, t *t
 			return fmt.Errorf("NoID is set for the datasource")
 		}
 
-		client := testAccProvider.Meta().(*ns1.Client)
+		client := testAccProvider.Meta().(*providerMeta).client
 
 		foundFeed, _, err := client.DataFeeds.Get(ds.Primary.Attributes["id"], rs.Primary.Attributes["id"])
 
@@ -96,7 +95,7 @@ func testAccCheckDataFeedExists(n string, dsrc string, dataFeed *data.Feed, t *t
 }
 
 func testAccCheckDataFeedDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ns1.Client)
+	client := testAccProvider.Meta().(*providerMeta).client
 
 	var dataFeedID string
 	var dataSourceID string
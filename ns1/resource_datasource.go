@@ -3,7 +3,6 @@ package ns1
 import (
 	"github.com/hashicorp/terraform/helper/schema"
 
-	ns1 "gopkg.in/ns1/ns1-go.v2/rest"
 	"gopkg.in/ns1/ns1-go.v2/rest/model/data"
 )
 
@@ -40,7 +39,7 @@ func dataSourceToResourceData(d *schema.ResourceData, s *data.Source) {
 
 // DataSourceCreate creates an ns1 datasource
 func DataSourceCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ns1.Client)
+	client := meta.(*providerMeta).client
 	s := data.NewSource(d.Get("name").(string), d.Get("sourcetype").(string))
 	s.Config = d.Get("config").(map[string]interface{})
 	if _, err := client.DataSources.Create(s); err != nil {
@@ -52,7 +51,7 @@ func DataSourceCreate(d *schema.ResourceData, meta interface{}) error {
 
 // DataSourceRead fetches info for the given datasource from ns1
 func DataSourceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ns1.Client)
+	client := meta.(*providerMeta).client
 	s, _, err := client.DataSources.Get(d.Id())
 	if err != nil {
 		return err
@@ -63,7 +62,7 @@ func DataSourceRead(d *schema.ResourceData, meta interface{}) error {
 
 // DataSourceDelete deteltes the given datasource from ns1
 func DataSourceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ns1.Client)
+	client := meta.(*providerMeta).client
 	_, err := client.DataSources.Delete(d.Id())
 	d.SetId("")
 	return err
@@ -71,7 +70,7 @@ func DataSourceDelete(d *schema.ResourceData, meta interface{}) error {
 
 // DataSourceUpdate updates the datasource with given parameters
 func DataSourceUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ns1.Client)
+	client := meta.(*providerMeta).client
 	s := data.NewSource(d.Get("name").(string), d.Get("sourcetype").(string))
 	s.ID = d.Id()
 	if _, err := client.DataSources.Update(s); err != nil {
@@ -7,7 +7,6 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 
-	ns1 "gopkg.in/ns1/ns1-go.v2/rest"
 	"gopkg.in/ns1/ns1-go.v2/rest/model/data"
 )
 
@@ -69,7 +68,7 @@ func testAccCheckDataSourceExists(n string, dataSource *data.Source) resource.Te
 			return fmt.Errorf("NoID is set")
 		}
 
-		client := testAccProvider.Meta().(*ns1.Client)
+		client := testAccProvider.Meta().(*providerMeta).client
 
 		foundSource, _, err := client.DataSources.Get(rs.Primary.Attributes["id"])
 
@@ -90,7 +89,7 @@ func testAccCheckDataSourceExists(n string, dataSource *data.Source) resource.Te
 }
 
 func testAccCheckDataSourceDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ns1.Client)
+	client := testAccProvider.Meta().(*providerMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "ns1_datasource" {
@@ -0,0 +1,133 @@
+package ns1
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// dnsView wraps an NS1 /views resource, which the vendored SDK does not model.
+type dnsView struct {
+	Name       string   `json:"view_name"`
+	ReadACLs   []string `json:"read_acls"`
+	UpdateACLs []string `json:"update_acls"`
+	Zones      []string `json:"zones"`
+	Networks   []int    `json:"networks"`
+	Preference int      `json:"preference,omitempty"`
+}
+
+func resourceDNSView() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			// Required
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// Optional
+			"read_acls": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"update_acls": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"zones": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"networks": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"preference": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+		},
+		Create:   resourceDNSViewCreate,
+		Read:     resourceDNSViewRead,
+		Update:   resourceDNSViewUpdate,
+		Delete:   resourceDNSViewDelete,
+		Importer: &schema.ResourceImporter{State: schema.ImportStatePassthrough},
+	}
+}
+
+func dnsViewToResourceData(d *schema.ResourceData, v *dnsView) {
+	d.SetId(v.Name)
+	d.Set("name", v.Name)
+	d.Set("read_acls", v.ReadACLs)
+	d.Set("update_acls", v.UpdateACLs)
+	d.Set("zones", v.Zones)
+	d.Set("networks", v.Networks)
+	d.Set("preference", v.Preference)
+}
+
+func resourceDataToDNSView(d *schema.ResourceData) *dnsView {
+	v := &dnsView{
+		Name:       d.Get("name").(string),
+		ReadACLs:   listToStrings(d.Get("read_acls").([]interface{})),
+		UpdateACLs: listToStrings(d.Get("update_acls").([]interface{})),
+		Zones:      setToStrings(d.Get("zones").(*schema.Set)),
+		Preference: d.Get("preference").(int),
+	}
+	if networks, ok := d.GetOk("networks"); ok {
+		v.Networks = setToInts(networks.(*schema.Set))
+	}
+	return v
+}
+
+// resourceDNSViewCreate creates the given view in ns1
+func resourceDNSViewCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+	v := resourceDataToDNSView(d)
+	if _, err := apiRequest(client, "PUT", fmt.Sprintf("views/%s", v.Name), v, v); err != nil {
+		return err
+	}
+	dnsViewToResourceData(d, v)
+	return nil
+}
+
+// resourceDNSViewRead reads the given view from ns1
+func resourceDNSViewRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+	var v dnsView
+	if _, err := apiRequest(client, "GET", fmt.Sprintf("views/%s", d.Id()), nil, &v); err != nil {
+		if apiNotFound(err) {
+			log.Printf("[WARN] view %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	dnsViewToResourceData(d, &v)
+	return nil
+}
+
+// resourceDNSViewUpdate updates the given view in ns1
+func resourceDNSViewUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+	v := resourceDataToDNSView(d)
+	if _, err := apiRequest(client, "POST", fmt.Sprintf("views/%s", v.Name), v, v); err != nil {
+		return err
+	}
+	dnsViewToResourceData(d, v)
+	return nil
+}
+
+// resourceDNSViewDelete deletes the given view from ns1
+func resourceDNSViewDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+	_, err := apiRequest(client, "DELETE", fmt.Sprintf("views/%s", d.Id()), nil, nil)
+	d.SetId("")
+	return err
+}
@@ -0,0 +1,101 @@
+package ns1
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccDNSView_basic(t *testing.T) {
+	rString := acctest.RandStringFromCharSet(15, acctest.CharSetAlphaNum)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDNSViewDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSViewBasic(rString, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSViewExists("ns1_dnsview.office"),
+					resource.TestCheckResourceAttr("ns1_dnsview.office", "read_acls.#", "1"),
+					resource.TestCheckResourceAttr("ns1_dnsview.office", "read_acls.0", fmt.Sprintf("terraform-test-%s", rString)),
+					resource.TestCheckResourceAttr("ns1_dnsview.office", "zones.#", "1"),
+					resource.TestCheckResourceAttr("ns1_dnsview.office", "preference", "10"),
+				),
+			},
+			{
+				Config: testAccDNSViewBasic(rString, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSViewExists("ns1_dnsview.office"),
+					resource.TestCheckResourceAttr("ns1_dnsview.office", "preference", "20"),
+				),
+			},
+			{
+				ResourceName:      "ns1_dnsview.office",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDNSViewExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("NoID is set")
+		}
+
+		client := testAccProvider.Meta().(*providerMeta).client
+		var v dnsView
+		if _, err := apiRequest(client, "GET", fmt.Sprintf("views/%s", rs.Primary.ID), nil, &v); err != nil {
+			return err
+		}
+		if v.Name != rs.Primary.ID {
+			return fmt.Errorf("view not found")
+		}
+		return nil
+	}
+}
+
+func testAccCheckDNSViewDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*providerMeta).client
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ns1_dnsview" {
+			continue
+		}
+		_, err := apiRequest(client, "GET", fmt.Sprintf("views/%s", rs.Primary.ID), nil, &dnsView{})
+		if err == nil {
+			return fmt.Errorf("view still exists: %s", rs.Primary.ID)
+		}
+		if !apiNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccDNSViewBasic(rString string, preference int) string {
+	return fmt.Sprintf(`resource "ns1_zone" "internal" {
+  zone = "terraform-test-%[1]s.io"
+}
+
+resource "ns1_acl" "office" {
+  name         = "terraform-test-%[1]s"
+  src_prefixes = ["10.0.0.0/8"]
+}
+
+resource "ns1_dnsview" "office" {
+  name       = "terraform-test-%[1]s"
+  read_acls  = ["${ns1_acl.office.name}"]
+  zones      = ["${ns1_zone.internal.zone}"]
+  preference = %[2]d
+}
+`, rString, preference)
+}
@@ -7,7 +7,6 @@ import (
 
 	"github.com/hashicorp/terraform/helper/schema"
 
-	ns1 "gopkg.in/ns1/ns1-go.v2/rest"
 	"gopkg.in/ns1/ns1-go.v2/rest/model/monitor"
 )
 
@@ -248,7 +247,7 @@ func resourceDataToMonitoringJob(r *monitor.Job, d *schema.ResourceData) error {
 
 // MonitoringJobCreate Creates monitoring job in ns1
 func MonitoringJobCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ns1.Client)
+	client := meta.(*providerMeta).client
 	j := monitor.Job{}
 	if err := resourceDataToMonitoringJob(&j, d); err != nil {
 		return err
@@ -261,7 +260,7 @@ func MonitoringJobCreate(d *schema.ResourceData, meta interface{}) error {
 
 // MonitoringJobRead reads the given monitoring job from ns1
 func MonitoringJobRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ns1.Client)
+	client := meta.(*providerMeta).client
 	j, _, err := client.Jobs.Get(d.Id())
 	if err != nil {
 		return err
@@ -271,7 +270,7 @@ func MonitoringJobRead(d *schema.ResourceData, meta interface{}) error {
 
 // MonitoringJobDelete deteltes the given monitoring job from ns1
 func MonitoringJobDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ns1.Client)
+	client := meta.(*providerMeta).client
 	_, err := client.Jobs.Delete(d.Id())
 	d.SetId("")
 	return err
@@ -279,7 +278,7 @@ func MonitoringJobDelete(d *schema.ResourceData, meta interface{}) error {
 
 // MonitoringJobUpdate updates the given monitoring job
 func MonitoringJobUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ns1.Client)
+	client := meta.(*providerMeta).client
 	j := monitor.Job{
 		ID: d.Id(),
 	}
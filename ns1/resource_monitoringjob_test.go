@@ -8,7 +8,6 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 
-	ns1 "gopkg.in/ns1/ns1-go.v2/rest"
 	"gopkg.in/ns1/ns1-go.v2/rest/model/monitor"
 )
 
@@ -125,7 +124,7 @@ func testAccCheckMonitoringJobExists(n string, monitoringJob *monitor.Job) resou
 			return fmt.Errorf("ID is not set")
 		}
 
-		client := testAccProvider.Meta().(*ns1.Client)
+		client := testAccProvider.Meta().(*providerMeta).client
 
 		foundMj, _, err := client.Jobs.Get(id)
 
@@ -144,7 +143,7 @@ func testAccCheckMonitoringJobExists(n string, monitoringJob *monitor.Job) resou
 }
 
 func testAccCheckMonitoringJobDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ns1.Client)
+	client := testAccProvider.Meta().(*providerMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "ns1_monitoringjob" {
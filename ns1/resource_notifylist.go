@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform/helper/schema"
 
-	ns1 "gopkg.in/ns1/ns1-go.v2/rest"
 	"gopkg.in/ns1/ns1-go.v2/rest/model/monitor"
 )
 
@@ -93,7 +92,7 @@ func resourceDataToNotifyList(nl *monitor.NotifyList, d *schema.ResourceData) er
 
 // NotifyListCreate creates an ns1 notifylist
 func NotifyListCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ns1.Client)
+	client := meta.(*providerMeta).client
 	nl := monitor.NewNotifyList(d.Get("name").(string))
 
 	if err := resourceDataToNotifyList(nl, d); err != nil {
@@ -109,7 +108,7 @@ func NotifyListCreate(d *schema.ResourceData, meta interface{}) error {
 
 // NotifyListRead fetches info for the given notifylist from ns1
 func NotifyListRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ns1.Client)
+	client := meta.(*providerMeta).client
 
 	nl, _, err := client.Notifications.Get(d.Id())
 	if err != nil {
@@ -121,7 +120,7 @@ func NotifyListRead(d *schema.ResourceData, meta interface{}) error {
 
 // NotifyListDelete deletes the given notifylist from ns1
 func NotifyListDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ns1.Client)
+	client := meta.(*providerMeta).client
 
 	_, err := client.Notifications.Delete(d.Id())
 	d.SetId("")
@@ -131,7 +130,7 @@ func NotifyListDelete(d *schema.ResourceData, meta interface{}) error {
 
 // NotifyListUpdate updates the notifylist with given parameters
 func NotifyListUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ns1.Client)
+	client := meta.(*providerMeta).client
 
 	nl := monitor.NewNotifyList(d.Get("name").(string))
 
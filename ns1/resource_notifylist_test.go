@@ -8,7 +8,6 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 
-	ns1 "gopkg.in/ns1/ns1-go.v2/rest"
 	"gopkg.in/ns1/ns1-go.v2/rest/model/monitor"
 )
 
@@ -129,7 +128,7 @@ func testAccCheckNotifyListExists(n string, nl *monitor.NotifyList) resource.Tes
 			return fmt.Errorf("ID is not set")
 		}
 
-		client := testAccProvider.Meta().(*ns1.Client)
+		client := testAccProvider.Meta().(*providerMeta).client
 
 		foundNl, _, err := client.Notifications.Get(id)
 
@@ -148,7 +147,7 @@ func testAccCheckNotifyListExists(n string, nl *monitor.NotifyList) resource.Tes
 }
 
 func testAccCheckNotifyListDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ns1.Client)
+	client := testAccProvider.Meta().(*providerMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "ns1_notifylist" {
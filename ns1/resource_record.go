@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"regexp"
 	"sort"
 	"strings"
 
@@ -69,11 +70,22 @@ func recordResource() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"target_answers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"use_client_subnet": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  true,
 			},
+			// Only used when destroying the record.
+			"delete_protection_qps_threshold": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validateQPSThreshold,
+			},
 			"short_answers": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -136,11 +148,12 @@ func recordResource() *schema.Resource {
 				},
 			},
 		},
-		Create:   RecordCreate,
-		Read:     RecordRead,
-		Update:   RecordUpdate,
-		Delete:   RecordDelete,
-		Importer: &schema.ResourceImporter{State: recordStateFunc},
+		CustomizeDiff: recordCustomizeDiff,
+		Create:        RecordCreate,
+		Read:          RecordRead,
+		Update:        RecordUpdate,
+		Delete:        RecordDelete,
+		Importer:      &schema.ResourceImporter{State: recordStateFunc},
 	}
 }
 
@@ -369,32 +382,68 @@ func resourceDataToRecord(r *dns.Record, d *schema.ResourceData) error {
 
 // RecordCreate creates DNS record in ns1
 func RecordCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ns1.Client)
+	client := meta.(*providerMeta).client
 	r := dns.NewRecord(d.Get("zone").(string), d.Get("domain").(string), d.Get("type").(string))
 	if err := resourceDataToRecord(r, d); err != nil {
 		return err
 	}
+	if r.Link != "" {
+		target, err := getRecordLinkTarget(client, r.Link, r.Type)
+		if err != nil {
+			return fmt.Errorf("could not read link target %s: %s", r.Link, err)
+		}
+		if err := checkRecordLinkTarget(target, r.Type); err != nil {
+			return err
+		}
+	}
 	if _, err := client.Records.Create(r); err != nil {
 		return err
 	}
-	return recordToResourceData(d, r)
+	if err := recordToResourceData(d, r); err != nil {
+		return err
+	}
+	return recordReadLinkTarget(d, client, r)
 }
 
 // RecordRead reads the DNS record from ns1
 func RecordRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ns1.Client)
+	client := meta.(*providerMeta).client
 
 	r, _, err := client.Records.Get(d.Get("zone").(string), d.Get("domain").(string), d.Get("type").(string))
 	if err != nil {
 		return err
 	}
 
-	return recordToResourceData(d, r)
+	if err := recordToResourceData(d, r); err != nil {
+		return err
+	}
+	return recordReadLinkTarget(d, client, r)
+}
+
+// recordReadLinkTarget sets the answers of the target of a linked record.
+func recordReadLinkTarget(d *schema.ResourceData, client *ns1.Client, r *dns.Record) error {
+	if r.Link == "" {
+		d.Set("target_answers", nil)
+		return nil
+	}
+	target, err := getRecordLinkTarget(client, r.Link, r.Type)
+	if err == ns1.ErrRecordMissing {
+		log.Printf("[WARN] link target %s of record %s does not exist", r.Link, r)
+		d.Set("target_answers", nil)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return d.Set("target_answers", recordLinkTargetAnswers(target))
 }
 
 // RecordDelete deletes the DNS record from ns1
 func RecordDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ns1.Client)
+	client := meta.(*providerMeta).client
+	if err := checkRecordDeleteProtection(d, meta.(*providerMeta)); err != nil {
+		return err
+	}
 	_, err := client.Records.Delete(d.Get("zone").(string), d.Get("domain").(string), d.Get("type").(string))
 	d.SetId("")
 	return err
@@ -402,7 +451,7 @@ func RecordDelete(d *schema.ResourceData, meta interface{}) error {
 
 // RecordUpdate updates the given dns record in ns1
 func RecordUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ns1.Client)
+	client := meta.(*providerMeta).client
 	r := dns.NewRecord(d.Get("zone").(string), d.Get("domain").(string), d.Get("type").(string))
 	if err := resourceDataToRecord(r, d); err != nil {
 		return err
@@ -413,15 +462,56 @@ func RecordUpdate(d *schema.ResourceData, meta interface{}) error {
 	return recordToResourceData(d, r)
 }
 
+// recordIDRegexp matches the ID NS1 assigns to a record.
+var recordIDRegexp = regexp.MustCompile(`^[0-9a-f]{24}$`)
+
 func recordStateFunc(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	parts := strings.Split(d.Id(), "/")
-	if len(parts) != 3 {
-		return nil, fmt.Errorf("invalid record specifier.  Expecting 2 slashes (\"zone/domain/type\"), got %d", len(parts)-1)
+	switch {
+	case len(parts) == 1 && recordIDRegexp.MatchString(parts[0]):
+		// Only the record ID, look for it in every zone of the account. This
+		// reads the zones one by one, so zone/id is preferred.
+		client := meta.(*providerMeta).client
+		zones, _, err := client.Zones.List()
+		if err != nil {
+			return nil, err
+		}
+		log.Printf("[WARN] looking for record %s in %d zones, import it as <zone>/%s to avoid it", parts[0], len(zones), parts[0])
+		names := make([]string, len(zones))
+		for i, z := range zones {
+			names[i] = z.Zone
+		}
+		return recordStateFromID(d, client, names, parts[0])
+	case len(parts) == 2 && recordIDRegexp.MatchString(parts[1]):
+		return recordStateFromID(d, meta.(*providerMeta).client, parts[:1], parts[1])
+	case len(parts) >= 3:
+		// Domains may themselves contain slashes, e.g. RFC 2317 reverse zones.
+		d.Set("zone", parts[0])
+		d.Set("domain", strings.Join(parts[1:len(parts)-1], "/"))
+		d.Set("type", parts[len(parts)-1])
+		return []*schema.ResourceData{d}, nil
 	}
+	return nil, fmt.Errorf("invalid record specifier.  Expecting \"zone/domain/type\", \"zone/id\" or \"id\", got %q", d.Id())
+}
 
-	d.Set("zone", parts[0])
-	d.Set("domain", parts[1])
-	d.Set("type", parts[2])
-
-	return []*schema.ResourceData{d}, nil
+// recordStateFromID looks for the record with the given ID in zones, in order,
+// and sets its zone, domain and type. It stops at the first zone holding the
+// record.
+func recordStateFromID(d *schema.ResourceData, client *ns1.Client, zones []string, id string) ([]*schema.ResourceData, error) {
+	for _, name := range zones {
+		z, _, err := client.Zones.Get(name)
+		if err != nil {
+			return nil, err
+		}
+		for _, zr := range z.Records {
+			if zr.ID == id {
+				d.SetId(id)
+				d.Set("zone", z.Zone)
+				d.Set("domain", zr.Domain)
+				d.Set("type", zr.Type)
+				return []*schema.ResourceData{d}, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("record %s not found", id)
 }
@@ -3,6 +3,7 @@ package ns1
 import (
 	"fmt"
 	"reflect"
+	"regexp"
 	"sort"
 	"testing"
 
@@ -95,6 +96,62 @@ func TestAccRecord_meta(t *testing.T) {
 	})
 }
 
+func TestAccRecord_link(t *testing.T) {
+	rString := acctest.RandStringFromCharSet(15, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordLink(rString, "A"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ns1_record.link", "target_answers.#", "1"),
+					resource.TestCheckResourceAttr("ns1_record.link", "target_answers.0", "1.2.3.4"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRecord_linkTypeMismatch(t *testing.T) {
+	rString := acctest.RandStringFromCharSet(15, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRecordLink(rString, "CNAME"),
+				ExpectError: regexp.MustCompile(`is a A record, it can't be linked to by a CNAME record`),
+			},
+		},
+	})
+}
+
+func TestAccRecord_deleteProtectionQPS(t *testing.T) {
+	var record dns.Record
+	rString := acctest.RandStringFromCharSet(15, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				// The new record serves no traffic, so it can be destroyed.
+				Config: testAccRecordDeleteProtectionQPS(rString),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordExists("ns1_record.it", &record),
+					resource.TestCheckResourceAttr("ns1_record.it", "delete_protection_qps_threshold", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRecord_SPF(t *testing.T) {
 	var record dns.Record
 	resource.Test(t, resource.TestCase{
@@ -140,6 +197,53 @@ func TestAccRecord_SRV(t *testing.T) {
 	})
 }
 
+func TestAccRecord_importByID(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordBasic,
+			},
+			{
+				ResourceName:      "ns1_record.it",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["ns1_record.it"]
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["zone"], rs.Primary.ID), nil
+				},
+			},
+		},
+	})
+}
+
+func TestRecordStateFunc(t *testing.T) {
+	cases := []struct {
+		id, zone, domain, t string
+	}{
+		{"example.io/www.example.io/A", "example.io", "www.example.io", "A"},
+		{"2.0.192.in-addr.arpa/0/26.2.0.192.in-addr.arpa/NS", "2.0.192.in-addr.arpa", "0/26.2.0.192.in-addr.arpa", "NS"},
+	}
+	for _, c := range cases {
+		d := recordResource().Data(nil)
+		d.SetId(c.id)
+		if _, err := recordStateFunc(d, nil); err != nil {
+			t.Fatalf("%s: err: %s", c.id, err)
+		}
+		if d.Get("zone") != c.zone || d.Get("domain") != c.domain || d.Get("type") != c.t {
+			t.Errorf("%s: got %s %s %s", c.id, d.Get("zone"), d.Get("domain"), d.Get("type"))
+		}
+	}
+
+	d := recordResource().Data(nil)
+	d.SetId("example.io/www.example.io")
+	if _, err := recordStateFunc(d, nil); err == nil {
+		t.Errorf("expected an error for an incomplete specifier")
+	}
+}
+
 func testAccCheckRecordExists(n string, record *dns.Record) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -151,7 +255,7 @@ func testAccCheckRecordExists(n string, record *dns.Record) resource.TestCheckFu
 			return fmt.Errorf("NoID is set")
 		}
 
-		client := testAccProvider.Meta().(*ns1.Client)
+		client := testAccProvider.Meta().(*providerMeta).client
 
 		p := rs.Primary
 
@@ -171,7 +275,7 @@ func testAccCheckRecordExists(n string, record *dns.Record) resource.TestCheckFu
 }
 
 func testAccCheckRecordDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ns1.Client)
+	client := testAccProvider.Meta().(*providerMeta).client
 
 	var recordDomain string
 	var recordZone string
@@ -385,6 +489,49 @@ resource "ns1_zone" "test" {
 }
 `
 
+func testAccRecordLink(rString, linkType string) string {
+	return fmt.Sprintf(`
+resource "ns1_record" "target" {
+	zone   = "${ns1_zone.test.zone}"
+	domain = "target.${ns1_zone.test.zone}"
+	type   = "A"
+	answers {
+		answer = "1.2.3.4"
+	}
+}
+
+resource "ns1_record" "link" {
+	zone   = "${ns1_zone.test.zone}"
+	domain = "link.${ns1_zone.test.zone}"
+	type   = "%s"
+	link   = "${ns1_record.target.domain}"
+}
+
+resource "ns1_zone" "test" {
+	zone = "terraform-test-%s.io"
+}
+`, linkType, rString)
+}
+
+func testAccRecordDeleteProtectionQPS(rString string) string {
+	return fmt.Sprintf(`
+resource "ns1_record" "it" {
+	zone   = "${ns1_zone.test.zone}"
+	domain = "test.${ns1_zone.test.zone}"
+	type   = "A"
+	answers {
+		answer = "1.2.3.4"
+	}
+
+	delete_protection_qps_threshold = 0
+}
+
+resource "ns1_zone" "test" {
+	zone = "terraform-test-%s.io"
+}
+`, rString)
+}
+
 func testAccRecordMeta(rString string) string {
 	return fmt.Sprintf(`
 resource "ns1_record" "it" {
@@ -0,0 +1,245 @@
+package ns1
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	ns1 "gopkg.in/ns1/ns1-go.v2/rest"
+	"gopkg.in/ns1/ns1-go.v2/rest/model/dns"
+)
+
+var redirectForwardingTypeStringEnum = NewStringEnum([]string{
+	"permanent",
+	"temporary",
+	"masking",
+})
+
+var redirectForwardingModeStringEnum = NewStringEnum([]string{
+	"all",
+	"capture",
+	"none",
+})
+
+// redirect wraps an NS1 /redirect resource, which the vendored SDK does not
+// model.
+type redirect struct {
+	ID              string   `json:"id,omitempty"`
+	Domain          string   `json:"domain"`
+	Path            string   `json:"path"`
+	Target          string   `json:"target"`
+	ForwardingType  string   `json:"forwarding_type"`
+	ForwardingMode  string   `json:"forwarding_mode"`
+	QueryForwarding bool     `json:"query_forwarding"`
+	SSLEnabled      bool     `json:"ssl_enabled"`
+	ForceRedirect   bool     `json:"force_redirect"`
+	CertificateID   string   `json:"certificate_id,omitempty"`
+	Tags            []string `json:"tags"`
+}
+
+func resourceRedirect() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			// Required
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"target": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateRedirectTarget,
+			},
+			// Optional
+			"path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "/",
+				ValidateFunc: validateRedirectPath,
+			},
+			"forwarding_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "permanent",
+				ValidateFunc: redirectForwardingTypeStringEnum.ValidateFunc,
+			},
+			"forwarding_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "all",
+				ValidateFunc: redirectForwardingModeStringEnum.ValidateFunc,
+			},
+			"query_forwarding": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"https_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"https_forced": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"certificate_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		CustomizeDiff: resourceRedirectCustomizeDiff,
+		Create:        resourceRedirectCreate,
+		Read:          resourceRedirectRead,
+		Update:        resourceRedirectUpdate,
+		Delete:        resourceRedirectDelete,
+		Importer:      &schema.ResourceImporter{State: schema.ImportStatePassthrough},
+	}
+}
+
+func redirectToResourceData(d *schema.ResourceData, r *redirect) {
+	d.SetId(r.ID)
+	d.Set("domain", r.Domain)
+	d.Set("path", r.Path)
+	d.Set("target", r.Target)
+	d.Set("forwarding_type", r.ForwardingType)
+	d.Set("forwarding_mode", r.ForwardingMode)
+	d.Set("query_forwarding", r.QueryForwarding)
+	d.Set("https_enabled", r.SSLEnabled)
+	d.Set("https_forced", r.ForceRedirect)
+	d.Set("certificate_id", r.CertificateID)
+	d.Set("tags", r.Tags)
+}
+
+func resourceDataToRedirect(d *schema.ResourceData) *redirect {
+	return &redirect{
+		ID:              d.Id(),
+		Domain:          d.Get("domain").(string),
+		Path:            d.Get("path").(string),
+		Target:          d.Get("target").(string),
+		ForwardingType:  d.Get("forwarding_type").(string),
+		ForwardingMode:  d.Get("forwarding_mode").(string),
+		QueryForwarding: d.Get("query_forwarding").(bool),
+		SSLEnabled:      d.Get("https_enabled").(bool),
+		ForceRedirect:   d.Get("https_forced").(bool),
+		CertificateID:   d.Get("certificate_id").(string),
+		Tags:            setToStrings(d.Get("tags").(*schema.Set)),
+	}
+}
+
+// zoneOfDomain returns the zone of zones holding domain, the longest suffix of
+// domain, or an empty string if there is none.
+func zoneOfDomain(zones []*dns.Zone, domain string) string {
+	names := make(map[string]bool, len(zones))
+	for _, z := range zones {
+		names[strings.TrimSuffix(z.Zone, ".")] = true
+	}
+	for _, suffix := range domainSuffixes(domain) {
+		if names[suffix] {
+			return suffix
+		}
+	}
+	return ""
+}
+
+// checkRedirectDomain checks that domain is in a zone of the account. This is
+// done when creating the redirect rather than at plan time, as the zone may be
+// created in the same apply.
+func checkRedirectDomain(client *ns1.Client, domain string) error {
+	zones, _, err := client.Zones.List()
+	if err != nil {
+		return fmt.Errorf("could not list zones: %s", err)
+	}
+	if zoneOfDomain(zones, domain) == "" {
+		return fmt.Errorf("domain %s is not in a zone of the account, create the zone first", domain)
+	}
+	return nil
+}
+
+// resourceRedirectCustomizeDiff checks that HTTPS is enabled when it is
+// enforced.
+func resourceRedirectCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("https_forced").(bool) && !d.Get("https_enabled").(bool) {
+		return fmt.Errorf("https_forced requires https_enabled")
+	}
+	return nil
+}
+
+// resourceRedirectCreate creates the given redirect in ns1
+func resourceRedirectCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+	r := resourceDataToRedirect(d)
+	if err := checkRedirectDomain(client, r.Domain); err != nil {
+		return err
+	}
+	if _, err := apiRequest(client, "PUT", "redirect", r, r); err != nil {
+		return err
+	}
+	redirectToResourceData(d, r)
+	return nil
+}
+
+// resourceRedirectRead reads the given redirect from ns1
+func resourceRedirectRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+	var r redirect
+	if _, err := apiRequest(client, "GET", fmt.Sprintf("redirect/%s", d.Id()), nil, &r); err != nil {
+		if apiNotFound(err) {
+			log.Printf("[WARN] redirect %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	redirectToResourceData(d, &r)
+	return nil
+}
+
+// resourceRedirectUpdate updates the given redirect in ns1
+func resourceRedirectUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+	r := resourceDataToRedirect(d)
+	if _, err := apiRequest(client, "POST", fmt.Sprintf("redirect/%s", r.ID), r, r); err != nil {
+		return err
+	}
+	redirectToResourceData(d, r)
+	return nil
+}
+
+// resourceRedirectDelete deletes the given redirect from ns1
+func resourceRedirectDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+	_, err := apiRequest(client, "DELETE", fmt.Sprintf("redirect/%s", d.Id()), nil, nil)
+	d.SetId("")
+	return err
+}
+
+// validateRedirectPath (schema helper) checks that a redirect path is
+// absolute.
+func validateRedirectPath(v interface{}, k string) (ws []string, es []error) {
+	if !strings.HasPrefix(v.(string), "/") {
+		es = append(es, fmt.Errorf("%q must start with /, got %q", k, v))
+	}
+	return
+}
+
+// validateRedirectTarget (schema helper) checks that a redirect target is an
+// absolute HTTP or HTTPS URL.
+func validateRedirectTarget(v interface{}, k string) (ws []string, es []error) {
+	u, err := url.Parse(v.(string))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		es = append(es, fmt.Errorf("%q must be an absolute http or https URL, got %q", k, v))
+	}
+	return
+}
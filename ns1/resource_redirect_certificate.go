@@ -0,0 +1,107 @@
+package ns1
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// redirectCertificate wraps an NS1 /redirect/certificates resource, which the
+// vendored SDK does not model.
+type redirectCertificate struct {
+	ID          string `json:"id,omitempty"`
+	Domain      string `json:"domain"`
+	Certificate string `json:"certificate,omitempty"`
+	ValidFrom   int    `json:"valid_from,omitempty"`
+	ValidUntil  int    `json:"valid_until,omitempty"`
+	Processing  bool   `json:"processing,omitempty"`
+	Errors      string `json:"errors,omitempty"`
+}
+
+func resourceRedirectCertificate() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			// Required
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// Computed
+			"certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"valid_from": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"valid_until": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"processing": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"errors": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Create:   resourceRedirectCertificateCreate,
+		Read:     resourceRedirectCertificateRead,
+		Delete:   resourceRedirectCertificateDelete,
+		Importer: &schema.ResourceImporter{State: schema.ImportStatePassthrough},
+	}
+}
+
+func redirectCertificateToResourceData(d *schema.ResourceData, c *redirectCertificate) {
+	d.SetId(c.ID)
+	d.Set("domain", c.Domain)
+	d.Set("certificate", c.Certificate)
+	d.Set("valid_from", c.ValidFrom)
+	d.Set("valid_until", c.ValidUntil)
+	d.Set("processing", c.Processing)
+	d.Set("errors", c.Errors)
+}
+
+// resourceRedirectCertificateCreate requests a certificate for the given
+// domain from ns1
+func resourceRedirectCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+	c := &redirectCertificate{Domain: d.Get("domain").(string)}
+	if err := checkRedirectDomain(client, c.Domain); err != nil {
+		return err
+	}
+	if _, err := apiRequest(client, "PUT", "redirect/certificates", c, c); err != nil {
+		return err
+	}
+	redirectCertificateToResourceData(d, c)
+	return nil
+}
+
+// resourceRedirectCertificateRead reads the given certificate from ns1
+func resourceRedirectCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+	var c redirectCertificate
+	if _, err := apiRequest(client, "GET", fmt.Sprintf("redirect/certificates/%s", d.Id()), nil, &c); err != nil {
+		if apiNotFound(err) {
+			log.Printf("[WARN] redirect certificate %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	redirectCertificateToResourceData(d, &c)
+	return nil
+}
+
+// resourceRedirectCertificateDelete revokes the given certificate in ns1
+func resourceRedirectCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+	_, err := apiRequest(client, "DELETE", fmt.Sprintf("redirect/certificates/%s", d.Id()), nil, nil)
+	d.SetId("")
+	return err
+}
@@ -0,0 +1,62 @@
+package ns1
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccRedirectCertificate_basic(t *testing.T) {
+	rString := acctest.RandStringFromCharSet(15, acctest.CharSetAlphaNum)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRedirectCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedirectCertificateBasic(rString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ns1_redirect_certificate.vanity", "domain", fmt.Sprintf("www.terraform-test-%s.io", rString)),
+					resource.TestCheckResourceAttrPair("ns1_redirect.vanity", "certificate_id", "ns1_redirect_certificate.vanity", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRedirectCertificateDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*providerMeta).client
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ns1_redirect_certificate" {
+			continue
+		}
+		_, err := apiRequest(client, "GET", fmt.Sprintf("redirect/certificates/%s", rs.Primary.ID), nil, &redirectCertificate{})
+		if err == nil {
+			return fmt.Errorf("redirect certificate still exists: %s", rs.Primary.ID)
+		}
+		if !apiNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccRedirectCertificateBasic(rString string) string {
+	return fmt.Sprintf(`resource "ns1_zone" "vanity" {
+  zone = "terraform-test-%s.io"
+}
+
+resource "ns1_redirect_certificate" "vanity" {
+  domain = "www.${ns1_zone.vanity.zone}"
+}
+
+resource "ns1_redirect" "vanity" {
+  domain         = "${ns1_redirect_certificate.vanity.domain}"
+  target         = "https://example.com/"
+  certificate_id = "${ns1_redirect_certificate.vanity.id}"
+}
+`, rString)
+}
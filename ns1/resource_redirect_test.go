@@ -0,0 +1,150 @@
+package ns1
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"gopkg.in/ns1/ns1-go.v2/rest/model/dns"
+)
+
+func TestAccRedirect_basic(t *testing.T) {
+	rString := acctest.RandStringFromCharSet(15, acctest.CharSetAlphaNum)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRedirectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedirectBasic(rString, "https://example.com/", "permanent"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRedirectExists("ns1_redirect.vanity"),
+					resource.TestCheckResourceAttr("ns1_redirect.vanity", "domain", fmt.Sprintf("www.terraform-test-%s.io", rString)),
+					resource.TestCheckResourceAttr("ns1_redirect.vanity", "path", "/"),
+					resource.TestCheckResourceAttr("ns1_redirect.vanity", "forwarding_type", "permanent"),
+				),
+			},
+			{
+				Config: testAccRedirectBasic(rString, "https://example.com/landing", "temporary"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRedirectExists("ns1_redirect.vanity"),
+					resource.TestCheckResourceAttr("ns1_redirect.vanity", "target", "https://example.com/landing"),
+					resource.TestCheckResourceAttr("ns1_redirect.vanity", "forwarding_type", "temporary"),
+				),
+			},
+			{
+				ResourceName:      "ns1_redirect.vanity",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRedirect_unknownDomain(t *testing.T) {
+	rString := acctest.RandStringFromCharSet(15, acctest.CharSetAlphaNum)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRedirectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`resource "ns1_redirect" "vanity" {
+  domain = "www.terraform-test-%s.io"
+  target = "https://example.com/"
+}
+`, rString),
+				ExpectError: regexp.MustCompile(`is not in a zone of the account`),
+			},
+		},
+	})
+}
+
+func TestZoneOfDomain(t *testing.T) {
+	zones := []*dns.Zone{{Zone: "example.com"}, {Zone: "sub.example.com"}}
+	cases := map[string]string{
+		"example.com":         "example.com",
+		"www.example.com":     "example.com",
+		"www.sub.example.com": "sub.example.com",
+		"example.org":         "",
+	}
+	for domain, expected := range cases {
+		if got := zoneOfDomain(zones, domain); got != expected {
+			t.Errorf("%s: got zone %q, want %q", domain, got, expected)
+		}
+	}
+}
+
+func TestValidateRedirectTarget(t *testing.T) {
+	cases := []struct {
+		value string
+		valid bool
+	}{
+		{"https://example.com/", true},
+		{"http://example.com/path?q=1", true},
+		{"example.com", false},
+		{"ftp://example.com/", false},
+	}
+	for _, c := range cases {
+		_, es := validateRedirectTarget(c.value, "target")
+		if (len(es) == 0) != c.valid {
+			t.Errorf("%s: got errors %v, want valid %t", c.value, es, c.valid)
+		}
+	}
+}
+
+func testAccCheckRedirectExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("NoID is set")
+		}
+
+		client := testAccProvider.Meta().(*providerMeta).client
+		var r redirect
+		if _, err := apiRequest(client, "GET", fmt.Sprintf("redirect/%s", rs.Primary.ID), nil, &r); err != nil {
+			return err
+		}
+		if r.ID != rs.Primary.ID {
+			return fmt.Errorf("redirect not found")
+		}
+		return nil
+	}
+}
+
+func testAccCheckRedirectDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*providerMeta).client
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ns1_redirect" {
+			continue
+		}
+		_, err := apiRequest(client, "GET", fmt.Sprintf("redirect/%s", rs.Primary.ID), nil, &redirect{})
+		if err == nil {
+			return fmt.Errorf("redirect still exists: %s", rs.Primary.ID)
+		}
+		if !apiNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccRedirectBasic(rString, target, forwardingType string) string {
+	return fmt.Sprintf(`resource "ns1_zone" "vanity" {
+  zone = "terraform-test-%s.io"
+}
+
+resource "ns1_redirect" "vanity" {
+  domain          = "www.${ns1_zone.vanity.zone}"
+  target          = "%s"
+  forwarding_type = "%s"
+}
+`, rString, target, forwardingType)
+}
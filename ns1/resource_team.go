@@ -3,7 +3,6 @@ package ns1
 import (
 	"github.com/hashicorp/terraform/helper/schema"
 
-	ns1 "gopkg.in/ns1/ns1-go.v2/rest"
 	"gopkg.in/ns1/ns1-go.v2/rest/model/account"
 )
 
@@ -40,7 +39,7 @@ func resourceDataToTeam(t *account.Team, d *schema.ResourceData) error {
 
 // TeamCreate creates the given team in ns1
 func TeamCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ns1.Client)
+	client := meta.(*providerMeta).client
 	t := account.Team{}
 	if err := resourceDataToTeam(&t, d); err != nil {
 		return err
@@ -53,7 +52,7 @@ func TeamCreate(d *schema.ResourceData, meta interface{}) error {
 
 // TeamRead reads the team data from ns1
 func TeamRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ns1.Client)
+	client := meta.(*providerMeta).client
 	t, _, err := client.Teams.Get(d.Id())
 	if err != nil {
 		return err
@@ -63,7 +62,7 @@ func TeamRead(d *schema.ResourceData, meta interface{}) error {
 
 // TeamDelete deletes the given team from ns1
 func TeamDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ns1.Client)
+	client := meta.(*providerMeta).client
 	_, err := client.Teams.Delete(d.Id())
 	d.SetId("")
 	return err
@@ -71,7 +70,7 @@ func TeamDelete(d *schema.ResourceData, meta interface{}) error {
 
 // TeamUpdate updates the given team in ns1
 func TeamUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ns1.Client)
+	client := meta.(*providerMeta).client
 	t := account.Team{
 		ID: d.Id(),
 	}
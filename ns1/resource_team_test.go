@@ -8,7 +8,6 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 
-	ns1 "gopkg.in/ns1/ns1-go.v2/rest"
 	"gopkg.in/ns1/ns1-go.v2/rest/model/account"
 )
 
@@ -78,7 +77,7 @@ func testAccCheckTeamExists(n string, team *account.Team) resource.TestCheckFunc
 			return fmt.Errorf("NoID is set")
 		}
 
-		client := testAccProvider.Meta().(*ns1.Client)
+		client := testAccProvider.Meta().(*providerMeta).client
 
 		foundTeam, _, err := client.Teams.Get(rs.Primary.Attributes["id"])
 		if err != nil {
@@ -96,7 +95,7 @@ func testAccCheckTeamExists(n string, team *account.Team) resource.TestCheckFunc
 }
 
 func testAccCheckTeamDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ns1.Client)
+	client := testAccProvider.Meta().(*providerMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "ns1_team" {
@@ -0,0 +1,172 @@
+package ns1
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	ns1 "gopkg.in/ns1/ns1-go.v2/rest"
+)
+
+// defaultTSIGSecretLength is the length in bytes of generated TSIG secrets,
+// the output size of HMAC-SHA256 as recommended by RFC 8945 section 6.
+const defaultTSIGSecretLength = 32
+
+// tsigKey wraps an NS1 /tsig resource, which the vendored SDK does not model.
+type tsigKey struct {
+	Name      string `json:"name"`
+	Algorithm string `json:"algorithm"`
+	Secret    string `json:"secret"`
+}
+
+func resourceTSIGKey() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			// Required
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"algorithm": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tsigHashStringEnum.ValidateFunc,
+			},
+			// Optional
+			"secret": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"secret_length"},
+				ValidateFunc:  validateBase64,
+			},
+			// Only used when generating the secret.
+			"secret_length": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"secret"},
+				ValidateFunc:  validateTSIGSecretLength,
+			},
+		},
+		Create:   resourceTSIGKeyCreate,
+		Read:     resourceTSIGKeyRead,
+		Update:   resourceTSIGKeyUpdate,
+		Delete:   resourceTSIGKeyDelete,
+		Importer: &schema.ResourceImporter{State: schema.ImportStatePassthrough},
+	}
+}
+
+func tsigKeyToResourceData(d *schema.ResourceData, k *tsigKey) {
+	d.SetId(k.Name)
+	d.Set("name", k.Name)
+	d.Set("algorithm", k.Algorithm)
+	if k.Secret != "" {
+		d.Set("secret", k.Secret)
+	}
+}
+
+func resourceDataToTSIGKey(d *schema.ResourceData) *tsigKey {
+	return &tsigKey{
+		Name:      d.Get("name").(string),
+		Algorithm: d.Get("algorithm").(string),
+		Secret:    d.Get("secret").(string),
+	}
+}
+
+// generateTSIGSecret returns a random base64 encoded secret of length bytes.
+func generateTSIGSecret(length int) (string, error) {
+	b := make([]byte, length)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(b), nil
+}
+
+// getTSIGKey reads the given TSIG key from ns1.
+func getTSIGKey(client *ns1.Client, name string) (*tsigKey, error) {
+	var k tsigKey
+	if _, err := apiRequest(client, "GET", fmt.Sprintf("tsig/%s", name), nil, &k); err != nil {
+		return nil, err
+	}
+	return &k, nil
+}
+
+// resourceTSIGKeyCreate creates the given TSIG key in ns1
+func resourceTSIGKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+	k := resourceDataToTSIGKey(d)
+	if k.Secret == "" {
+		length := d.Get("secret_length").(int)
+		if length == 0 {
+			length = defaultTSIGSecretLength
+		}
+		secret, err := generateTSIGSecret(length)
+		if err != nil {
+			return fmt.Errorf("could not generate TSIG secret: %s", err)
+		}
+		k.Secret = secret
+	}
+	if _, err := apiRequest(client, "PUT", fmt.Sprintf("tsig/%s", k.Name), k, nil); err != nil {
+		return err
+	}
+	tsigKeyToResourceData(d, k)
+	return nil
+}
+
+// resourceTSIGKeyRead reads the given TSIG key from ns1
+func resourceTSIGKeyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+	k, err := getTSIGKey(client, d.Id())
+	if err != nil {
+		if apiNotFound(err) {
+			log.Printf("[WARN] TSIG key %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	tsigKeyToResourceData(d, k)
+	return nil
+}
+
+// resourceTSIGKeyUpdate updates the given TSIG key in ns1
+func resourceTSIGKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+	k := resourceDataToTSIGKey(d)
+	if _, err := apiRequest(client, "POST", fmt.Sprintf("tsig/%s", k.Name), k, nil); err != nil {
+		return err
+	}
+	tsigKeyToResourceData(d, k)
+	return nil
+}
+
+// resourceTSIGKeyDelete deletes the given TSIG key from ns1
+func resourceTSIGKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+	_, err := apiRequest(client, "DELETE", fmt.Sprintf("tsig/%s", d.Id()), nil, nil)
+	d.SetId("")
+	return err
+}
+
+// validateTSIGSecretLength (schema helper) checks the length of a generated
+// TSIG secret. RFC 8945 section 6 recommends at least 16 bytes.
+func validateTSIGSecretLength(v interface{}, k string) (ws []string, es []error) {
+	if length := v.(int); length < 16 || length > 512 {
+		es = append(es, fmt.Errorf("%q must be between 16 and 512 bytes, got %d", k, length))
+	}
+	return
+}
+
+// validateBase64 (schema helper) checks that a string is base64 encoded.
+func validateBase64(v interface{}, k string) (ws []string, es []error) {
+	if _, err := base64.StdEncoding.DecodeString(v.(string)); err != nil {
+		es = append(es, fmt.Errorf("%q must be base64 encoded: %s", k, err))
+	}
+	return
+}
@@ -0,0 +1,123 @@
+package ns1
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccTSIGKey_basic(t *testing.T) {
+	name := fmt.Sprintf("terraform-test-%s", acctest.RandStringFromCharSet(15, acctest.CharSetAlphaNum))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTSIGKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTSIGKeyGenerated(name, "hmac-sha256"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTSIGKeyExists("ns1_tsig_key.xfr"),
+					resource.TestCheckResourceAttr("ns1_tsig_key.xfr", "algorithm", "hmac-sha256"),
+					resource.TestCheckResourceAttrSet("ns1_tsig_key.xfr", "secret"),
+				),
+			},
+			{
+				Config: testAccTSIGKeyGenerated(name, "hmac-sha512"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTSIGKeyExists("ns1_tsig_key.xfr"),
+					resource.TestCheckResourceAttr("ns1_tsig_key.xfr", "algorithm", "hmac-sha512"),
+				),
+			},
+			{
+				ResourceName:            "ns1_tsig_key.xfr",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret_length"},
+			},
+		},
+	})
+}
+
+func TestGenerateTSIGSecret(t *testing.T) {
+	secret, err := generateTSIGSecret(64)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	b, err := base64.StdEncoding.DecodeString(secret)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(b) != 64 {
+		t.Fatalf("got a %d bytes secret, want 64", len(b))
+	}
+}
+
+func TestValidateTSIGSecretLength(t *testing.T) {
+	cases := []struct {
+		length int
+		valid  bool
+	}{
+		{8, false},
+		{16, true},
+		{64, true},
+		{1024, false},
+	}
+	for _, c := range cases {
+		_, es := validateTSIGSecretLength(c.length, "secret_length")
+		if (len(es) == 0) != c.valid {
+			t.Errorf("%d: got errors %v, want valid %t", c.length, es, c.valid)
+		}
+	}
+}
+
+func testAccCheckTSIGKeyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("NoID is set")
+		}
+
+		client := testAccProvider.Meta().(*providerMeta).client
+		k, err := getTSIGKey(client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if k.Name != rs.Primary.ID {
+			return fmt.Errorf("TSIG key not found")
+		}
+		return nil
+	}
+}
+
+func testAccCheckTSIGKeyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*providerMeta).client
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ns1_tsig_key" {
+			continue
+		}
+		_, err := getTSIGKey(client, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("TSIG key still exists: %s", rs.Primary.ID)
+		}
+		if !apiNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccTSIGKeyGenerated(name, algorithm string) string {
+	return fmt.Sprintf(`resource "ns1_tsig_key" "xfr" {
+  name          = "%s"
+  algorithm     = "%s"
+  secret_length = 64
+}
+`, name, algorithm)
+}
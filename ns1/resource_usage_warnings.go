@@ -0,0 +1,177 @@
+package ns1
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"gopkg.in/ns1/ns1-go.v2/rest/model/account"
+)
+
+// usageWarningsID is the ID of the single ns1_usage_warnings of an account.
+const usageWarningsID = "usagewarnings"
+
+// usageWarningSchema describes the overage warnings of a billed usage.
+var usageWarningSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"send_warnings": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+		},
+		"warning_1": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validatePercentage,
+		},
+		"warning_2": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validatePercentage,
+		},
+	},
+}
+
+// resourceUsageWarnings manages the overage warnings of the account of the
+// API key. There is a single instance per account: creating the resource
+// adopts the existing warnings, and destroying it only removes it from the
+// state.
+func resourceUsageWarnings() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"records": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem:     usageWarningSchema,
+			},
+			"queries": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem:     usageWarningSchema,
+			},
+		},
+		CustomizeDiff: resourceUsageWarningsCustomizeDiff,
+		Create:        resourceUsageWarningsCreate,
+		Read:          resourceUsageWarningsRead,
+		Update:        resourceUsageWarningsUpdate,
+		Delete:        resourceUsageWarningsDelete,
+		Importer:      &schema.ResourceImporter{State: schema.ImportStatePassthrough},
+	}
+}
+
+func usageWarningToMaps(w account.Warning) []map[string]interface{} {
+	return []map[string]interface{}{{
+		"send_warnings": w.Send,
+		"warning_1":     w.First,
+		"warning_2":     w.Second,
+	}}
+}
+
+func usageWarningsToResourceData(d *schema.ResourceData, uw *account.UsageWarning) {
+	d.SetId(usageWarningsID)
+	d.Set("records", usageWarningToMaps(uw.Records))
+	d.Set("queries", usageWarningToMaps(uw.Queries))
+}
+
+// resourceDataToUsageWarning overlays the configured warnings of key on w.
+func resourceDataToUsageWarning(w *account.Warning, d *schema.ResourceData, key string) {
+	if v, ok := d.GetOkExists(key + ".0.send_warnings"); ok {
+		w.Send = v.(bool)
+	}
+	if v, ok := d.GetOkExists(key + ".0.warning_1"); ok {
+		w.First = v.(int)
+	}
+	if v, ok := d.GetOkExists(key + ".0.warning_2"); ok {
+		w.Second = v.(int)
+	}
+}
+
+// checkUsageWarning checks that the first threshold of w is lower than the
+// second.
+func checkUsageWarning(key string, w account.Warning) error {
+	if w.First >= w.Second {
+		return fmt.Errorf("%s: warning_1 (%d%%) must be lower than warning_2 (%d%%)", key, w.First, w.Second)
+	}
+	return nil
+}
+
+// resourceUsageWarningsCustomizeDiff checks the thresholds of both warnings
+// against each other.
+func resourceUsageWarningsCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"records", "queries"} {
+		if !d.HasChange(key) || !d.NewValueKnown(key) {
+			continue
+		}
+		first, firstOk := d.GetOk(key + ".0.warning_1")
+		second, secondOk := d.GetOk(key + ".0.warning_2")
+		if !firstOk || !secondOk {
+			// The other threshold is read from NS1.
+			continue
+		}
+		if err := checkUsageWarning(key, account.Warning{First: first.(int), Second: second.(int)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resourceUsageWarningsCreate adopts the warnings of the account, and writes
+// the configured ones
+func resourceUsageWarningsCreate(d *schema.ResourceData, meta interface{}) error {
+	return resourceUsageWarningsUpdate(d, meta)
+}
+
+// resourceUsageWarningsRead reads the warnings of the account from ns1
+func resourceUsageWarningsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+	uw, _, err := client.Warnings.Get()
+	if err != nil {
+		return err
+	}
+	usageWarningsToResourceData(d, uw)
+	return nil
+}
+
+// resourceUsageWarningsUpdate writes the configured warnings of the account
+// in ns1
+func resourceUsageWarningsUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+	uw, _, err := client.Warnings.Get()
+	if err != nil {
+		return err
+	}
+	resourceDataToUsageWarning(&uw.Records, d, "records")
+	resourceDataToUsageWarning(&uw.Queries, d, "queries")
+	if err := checkUsageWarning("records", uw.Records); err != nil {
+		return err
+	}
+	if err := checkUsageWarning("queries", uw.Queries); err != nil {
+		return err
+	}
+	if _, err := client.Warnings.Update(uw); err != nil {
+		return err
+	}
+	usageWarningsToResourceData(d, uw)
+	return nil
+}
+
+// resourceUsageWarningsDelete removes the warnings from the state, they are
+// left unchanged in ns1
+func resourceUsageWarningsDelete(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+// validatePercentage (schema helper) checks that an int is a percentage.
+func validatePercentage(v interface{}, k string) (ws []string, es []error) {
+	if value := v.(int); value < 0 || value > 100 {
+		es = append(es, fmt.Errorf("%q must be a percentage between 0 and 100, got %d", k, value))
+	}
+	return
+}
@@ -0,0 +1,113 @@
+package ns1
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"gopkg.in/ns1/ns1-go.v2/rest/model/account"
+)
+
+// Usage warnings are shared by every test of the account, so the original
+// thresholds are put back once done.
+func TestAccUsageWarnings_basic(t *testing.T) {
+	var original *account.UsageWarning
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			var err error
+			if original, _, err = testAccClient(t).Warnings.Get(); err != nil {
+				t.Fatalf("could not read usage warnings: %s", err)
+			}
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(s *terraform.State) error {
+			if _, err := testAccClient(t).Warnings.Update(original); err != nil {
+				return fmt.Errorf("could not restore usage warnings: %s", err)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsageWarnings(80, 90),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ns1_usage_warnings.it", "records.0.send_warnings", "true"),
+					resource.TestCheckResourceAttr("ns1_usage_warnings.it", "records.0.warning_1", "80"),
+					resource.TestCheckResourceAttr("ns1_usage_warnings.it", "records.0.warning_2", "90"),
+				),
+			},
+			{
+				Config: testAccUsageWarnings(50, 75),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ns1_usage_warnings.it", "records.0.warning_1", "50"),
+					resource.TestCheckResourceAttr("ns1_usage_warnings.it", "records.0.warning_2", "75"),
+				),
+			},
+			{
+				ResourceName:      "ns1_usage_warnings.it",
+				ImportState:       true,
+				ImportStateId:     usageWarningsID,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccUsageWarnings_invalidThresholds(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccUsageWarnings(90, 80),
+				ExpectError: regexp.MustCompile(`warning_1 \(90%\) must be lower than warning_2 \(80%\)`),
+			},
+		},
+	})
+}
+
+func TestCheckUsageWarning(t *testing.T) {
+	cases := []struct {
+		warning account.Warning
+		valid   bool
+	}{
+		{account.Warning{First: 80, Second: 90}, true},
+		{account.Warning{First: 90, Second: 90}, false},
+		{account.Warning{First: 90, Second: 80}, false},
+	}
+	for _, c := range cases {
+		err := checkUsageWarning("records", c.warning)
+		if (err == nil) != c.valid {
+			t.Errorf("%+v: got error %v, want valid %t", c.warning, err, c.valid)
+		}
+	}
+}
+
+func TestValidatePercentage(t *testing.T) {
+	for value, valid := range map[int]bool{-1: false, 0: true, 100: true, 101: false} {
+		_, es := validatePercentage(value, "warning_1")
+		if (len(es) == 0) != valid {
+			t.Errorf("%d: got errors %v, want valid %t", value, es, valid)
+		}
+	}
+}
+
+func testAccUsageWarnings(first, second int) string {
+	return fmt.Sprintf(`resource "ns1_usage_warnings" "it" {
+  records {
+    send_warnings = true
+    warning_1     = %[1]d
+    warning_2     = %[2]d
+  }
+
+  queries {
+    send_warnings = true
+    warning_1     = %[1]d
+    warning_2     = %[2]d
+  }
+}
+`, first, second)
+}
@@ -3,7 +3,6 @@ package ns1
 import (
 	"github.com/hashicorp/terraform/helper/schema"
 
-	ns1 "gopkg.in/ns1/ns1-go.v2/rest"
 	"gopkg.in/ns1/ns1-go.v2/rest/model/account"
 )
 
@@ -78,7 +77,7 @@ func resourceDataToUser(u *account.User, d *schema.ResourceData) error {
 
 // UserCreate creates the given user in ns1
 func UserCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ns1.Client)
+	client := meta.(*providerMeta).client
 	u := account.User{}
 	if err := resourceDataToUser(&u, d); err != nil {
 		return err
@@ -91,7 +90,7 @@ func UserCreate(d *schema.ResourceData, meta interface{}) error {
 
 // UserRead  reads the given users data from ns1
 func UserRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ns1.Client)
+	client := meta.(*providerMeta).client
 	u, _, err := client.Users.Get(d.Id())
 	if err != nil {
 		return err
@@ -101,7 +100,7 @@ func UserRead(d *schema.ResourceData, meta interface{}) error {
 
 // UserDelete deletes the given user from ns1
 func UserDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ns1.Client)
+	client := meta.(*providerMeta).client
 	_, err := client.Users.Delete(d.Id())
 	d.SetId("")
 	return err
@@ -109,7 +108,7 @@ func UserDelete(d *schema.ResourceData, meta interface{}) error {
 
 // UserUpdate updates the user with given parameters in ns1
 func UserUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ns1.Client)
+	client := meta.(*providerMeta).client
 	u := account.User{
 		Username: d.Id(),
 	}
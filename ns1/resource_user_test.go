@@ -8,7 +8,6 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 
-	ns1 "gopkg.in/ns1/ns1-go.v2/rest"
 	"gopkg.in/ns1/ns1-go.v2/rest/model/account"
 )
 
@@ -40,7 +39,7 @@ func TestAccUser_basic(t *testing.T) {
 }
 
 func testAccCheckUserDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ns1.Client)
+	client := testAccProvider.Meta().(*providerMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "ns1_user" {
@@ -67,7 +66,7 @@ func testAccCheckUserExists(n string, user *account.User) resource.TestCheckFunc
 			return fmt.Errorf("No ID is set")
 		}
 
-		client := testAccProvider.Meta().(*ns1.Client)
+		client := testAccProvider.Meta().(*providerMeta).client
 
 		foundUser, _, err := client.Users.Get(rs.Primary.ID)
 		if err != nil {
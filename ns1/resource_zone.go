@@ -1,92 +1,292 @@
 package ns1
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 
 	ns1 "gopkg.in/ns1/ns1-go.v2/rest"
+	"gopkg.in/ns1/ns1-go.v2/rest/model/data"
 	"gopkg.in/ns1/ns1-go.v2/rest/model/dns"
 )
 
+var tsigHashStringEnum = NewStringEnum([]string{
+	"hmac-md5",
+	"hmac-sha1",
+	"hmac-sha224",
+	"hmac-sha256",
+	"hmac-sha384",
+	"hmac-sha512",
+})
+
 func resourceZone() *schema.Resource {
 	return &schema.Resource{
-		Schema: map[string]*schema.Schema{
-			// Required
-			"zone": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-			},
-			// Optional
-			"ttl": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Computed: true,
-			},
-			// SOA attributes per https://tools.ietf.org/html/rfc1035).
-			"refresh": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Computed: true,
-			},
-			"retry": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Computed: true,
-			},
-			"expiry": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Computed: true,
-			},
-			// SOA MINUMUM overloaded as NX TTL per https://tools.ietf.org/html/rfc2308
-			"nx_ttl": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Computed: true,
-			},
-			// TODO: test
-			"link": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+		Schema:        resourceZoneSchema(),
+		CustomizeDiff: resourceZoneCustomizeDiff,
+		SchemaVersion: 2,
+		StateUpgraders: []schema.StateUpgrader{
+			stateUpgrader(0, resourceZoneV0().Schema, resourceZoneStateUpgradeV0),
+			stateUpgrader(1, resourceZoneSchemaV1(), resourceZoneStateUpgradeV1),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Create:   resourceZoneCreate,
+		Read:     resourceZoneRead,
+		Update:   resourceZoneUpdate,
+		Delete:   resourceZoneDelete,
+		Importer: &schema.ResourceImporter{State: resourceZoneStateFunc},
+	}
+}
+
+func resourceZoneSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		// Required
+		"zone": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		// Optional
+		"ttl": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validateSOAValue,
+		},
+		// SOA attributes per https://tools.ietf.org/html/rfc1035).
+		"refresh": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validateSOAValue,
+		},
+		"retry": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validateSOAValue,
+		},
+		"expiry": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validateSOAValue,
+		},
+		// SOA MINUMUM overloaded as NX TTL per https://tools.ietf.org/html/rfc2308
+		"nx_ttl": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validateSOAValue,
+		},
+		// TODO: test
+		"link": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: true,
+		},
+		"target_soa": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     zoneLinkTargetSchema,
+		},
+		"primary": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: true,
+		},
+		"primary_port": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"additional_primaries": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"ip": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"port": {
+						Type:     schema.TypeInt,
+						Optional: true,
+						Default:  53,
+					},
+				},
 			},
-			"primary": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+		},
+		"primary_enabled": {
+			Type:          schema.TypeBool,
+			Optional:      true,
+			Default:       true,
+			ConflictsWith: []string{"primary"},
+			// Transfers are always disabled without secondaries.
+			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+				return len(d.Get("secondaries").([]interface{})) == 0
 			},
-			"additional_primaries": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
+		},
+		"secondaries": {
+			Type:          schema.TypeList,
+			Optional:      true,
+			ConflictsWith: []string{"primary"},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"ip": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"port": {
+						Type:     schema.TypeInt,
+						Optional: true,
+						Default:  53,
+					},
+					"notify": {
+						Type:     schema.TypeBool,
+						Optional: true,
+					},
+					"networks": {
+						Type:     schema.TypeSet,
+						Computed: true,
+						Elem:     &schema.Schema{Type: schema.TypeInt},
+					},
 				},
 			},
-			"dns_servers": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"hostmaster": {
-				Type:     schema.TypeString,
-				Computed: true,
+		},
+		"tsig": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					// Read from the ns1_tsig_key of the same name when
+					// key is not set.
+					"hash": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: tsigHashStringEnum.ValidateFunc,
+					},
+					"key": {
+						Type:      schema.TypeString,
+						Optional:  true,
+						Sensitive: true,
+					},
+				},
 			},
-			"networks": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Computed: true,
-				Elem:     &schema.Schema{Type: schema.TypeInt},
+		},
+		// Detects secret changes of the ns1_tsig_key used by the zone.
+		"tsig_key_fingerprint": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"wait_for_transfer": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"transfer_status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"last_transfer": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"expired": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"transfer_error": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"dns_servers": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"hostmaster": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validateHostmaster,
+		},
+		// Only used when creating the zone.
+		"autogenerate_ns_record": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+				return d.Id() != ""
 			},
 		},
-		Create:   resourceZoneCreate,
-		Read:     resourceZoneRead,
-		Update:   resourceZoneUpdate,
-		Delete:   resourceZoneDelete,
-		Importer: &schema.ResourceImporter{State: resourceZoneStateFunc},
+		// Only used when destroying the zone.
+		"deletion_protection": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"force_destroy": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"networks": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeInt},
+		},
+		"network_pools": {
+			Type:     schema.TypeSet,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"meta": {
+			Type:     schema.TypeMap,
+			Optional: true,
+		},
+		"dnssec": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
 	}
 }
 
+// resourceZoneCustomizeDiff runs the plan time checks of a zone.
+func resourceZoneCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if err := resourceZoneCustomizeDiffSOA(d, meta); err != nil {
+		return err
+	}
+	if err := resourceZoneCustomizeDiffLink(d, meta); err != nil {
+		return err
+	}
+	if err := resourceZoneCustomizeDiffTSIGKey(d, meta); err != nil {
+		return err
+	}
+	return resourceZoneCustomizeDiffNetworks(d, meta)
+}
+
 func resourceZoneToResourceData(d *schema.ResourceData, z *dns.Zone) {
 	d.SetId(z.ID)
 	d.Set("hostmaster", z.Hostmaster)
@@ -96,17 +296,73 @@ func resourceZoneToResourceData(d *schema.ResourceData, z *dns.Zone) {
 	d.Set("retry", z.Retry)
 	d.Set("expiry", z.Expiry)
 	d.Set("networks", z.NetworkIDs)
-	d.Set("dns_servers", strings.Join(z.DNSServers[:], ","))
+	d.Set("network_pools", z.NetworkPools)
+	d.Set("dns_servers", z.DNSServers)
+	if z.Meta != nil {
+		d.Set("meta", z.Meta.StringMap())
+	} else {
+		d.Set("meta", nil)
+	}
 	if z.Secondary != nil && z.Secondary.Enabled {
 		d.Set("primary", z.Secondary.PrimaryIP)
-		d.Set("additional_primaries", z.Secondary.OtherIPs)
+		d.Set("primary_port", z.Secondary.PrimaryPort)
+		d.Set("transfer_status", z.Secondary.Status)
+		d.Set("last_transfer", z.Secondary.LastXfr)
+		d.Set("expired", z.Secondary.Expired)
+		if z.Secondary.Error != nil {
+			d.Set("transfer_error", *z.Secondary.Error)
+		} else {
+			d.Set("transfer_error", "")
+		}
+		d.Set("additional_primaries", additionalPrimariesToMaps(z.Secondary))
+		if z.Secondary.TSIG != nil && z.Secondary.TSIG.Name != "" {
+			// The API only returns the encrypted key, keep the one we know.
+			d.Set("tsig", []map[string]interface{}{{
+				"enabled": z.Secondary.TSIG.Enabled,
+				"hash":    z.Secondary.TSIG.Hash,
+				"name":    z.Secondary.TSIG.Name,
+				"key":     d.Get("tsig.0.key").(string),
+			}})
+		} else {
+			d.Set("tsig", nil)
+		}
+	}
+	if z.Primary != nil {
+		d.Set("primary_enabled", z.Primary.Enabled)
+		secondaries := make([]map[string]interface{}, len(z.Primary.Secondaries))
+		for i, server := range z.Primary.Secondaries {
+			secondaries[i] = map[string]interface{}{
+				"ip":       server.IP,
+				"port":     server.Port,
+				"notify":   server.Notify,
+				"networks": server.NetworkIDs,
+			}
+		}
+		d.Set("secondaries", secondaries)
 	}
 	if z.Link != nil && *z.Link != "" {
 		d.Set("link", *z.Link)
 	}
 }
 
-func resourceToZoneData(z *dns.Zone, d *schema.ResourceData) {
+// additionalPrimariesToMaps returns the additional primaries of a secondary
+// zone as ip/port maps. Ports default to 53.
+func additionalPrimariesToMaps(s *dns.ZoneSecondary) []map[string]interface{} {
+	additionalPrimaries := make([]map[string]interface{}, len(s.OtherIPs))
+	for i, ip := range s.OtherIPs {
+		port := 53
+		if i < len(s.OtherPorts) {
+			port = s.OtherPorts[i]
+		}
+		additionalPrimaries[i] = map[string]interface{}{
+			"ip":   ip,
+			"port": port,
+		}
+	}
+	return additionalPrimaries
+}
+
+func resourceToZoneData(z *dns.Zone, d *schema.ResourceData) error {
 	z.ID = d.Id()
 	if v, ok := d.GetOk("hostmaster"); ok {
 		z.Hostmaster = v.(string)
@@ -128,20 +384,47 @@ func resourceToZoneData(z *dns.Zone, d *schema.ResourceData) {
 	}
 	if v, ok := d.GetOk("primary"); ok {
 		z.MakeSecondary(v.(string))
+	} else if rawSecondaries := d.Get("secondaries").([]interface{}); len(rawSecondaries) > 0 ||
+		d.HasChange("secondaries") || d.HasChange("primary_enabled") {
+		// Secondaries set outside of Terraform are kept unless these change.
+		secondaries := make([]dns.ZoneSecondaryServer, len(rawSecondaries))
+		for i, secondaryRaw := range rawSecondaries {
+			secondary := secondaryRaw.(map[string]interface{})
+			secondaries[i] = dns.ZoneSecondaryServer{
+				IP:     secondary["ip"].(string),
+				Port:   secondary["port"].(int),
+				Notify: secondary["notify"].(bool),
+			}
+		}
+		z.MakePrimary(secondaries...)
+		z.Primary.Enabled = d.Get("primary_enabled").(bool) && len(secondaries) > 0
+	}
+	if v, ok := d.GetOk("primary_port"); ok && z.Secondary != nil {
+		z.Secondary.PrimaryPort = v.(int)
 	}
 	if v, ok := d.GetOk("additional_primaries"); ok {
-		otherIPsRaw := v.([]interface{})
-		z.Secondary.OtherIPs = make([]string, len(otherIPsRaw))
-		for i, otherIP := range otherIPsRaw {
-			z.Secondary.OtherIPs[i] = otherIP.(string)
+		if z.Secondary == nil {
+			return errors.New("additional_primaries can only be set on secondary zones, set primary as well")
 		}
-		// Fill a list of matching length with '53' for OtherPorts
-		// to match functionality of MakeSecondary for PrimaryPort
-		// TODO: Add ability to set custom OtherPorts and PrimaryPort
-		//otherPorts := make([]int, len(z.Secondary.OtherIPs))
-		z.Secondary.OtherPorts = make([]int, len(z.Secondary.OtherIPs))
-		for i := range z.Secondary.OtherPorts {
-			z.Secondary.OtherPorts[i] = 53
+		additionalPrimariesRaw := v.([]interface{})
+		z.Secondary.OtherIPs = make([]string, len(additionalPrimariesRaw))
+		z.Secondary.OtherPorts = make([]int, len(additionalPrimariesRaw))
+		for i, additionalPrimaryRaw := range additionalPrimariesRaw {
+			additionalPrimary := additionalPrimaryRaw.(map[string]interface{})
+			z.Secondary.OtherIPs[i] = additionalPrimary["ip"].(string)
+			z.Secondary.OtherPorts[i] = additionalPrimary["port"].(int)
+		}
+	}
+	if v, ok := d.GetOk("tsig"); ok {
+		if z.Secondary == nil {
+			return errors.New("tsig can only be set on secondary zones, set primary as well")
+		}
+		tsig := v.([]interface{})[0].(map[string]interface{})
+		z.Secondary.TSIG = &dns.TSIG{
+			Enabled: tsig["enabled"].(bool),
+			Hash:    tsig["hash"].(string),
+			Name:    tsig["name"].(string),
+			Key:     tsig["key"].(string),
 		}
 	}
 	if v, ok := d.GetOk("link"); ok {
@@ -155,52 +438,335 @@ func resourceToZoneData(z *dns.Zone, d *schema.ResourceData) {
 		}
 		z.NetworkIDs = networkIDs
 	}
+	if v, ok := d.GetOk("meta"); ok {
+		z.Meta = data.MetaFromMap(v.(map[string]interface{}))
+		errs := z.Meta.Validate()
+		if len(errs) > 0 {
+			return errJoin(append([]error{errors.New("found error/s in zone metadata")}, errs...), ",")
+		}
+	} else if d.HasChange("meta") {
+		// NS1 keeps the metadata of a zone unless it is sent empty.
+		z.Meta = &data.Meta{}
+	}
+	return checkZoneSOATimers(z)
+}
+
+var (
+	// mailboxLocalPartRegexp matches the local part of a mailbox, as a
+	// RFC 5322 dot-atom.
+	mailboxLocalPartRegexp = regexp.MustCompile("^[A-Za-z0-9!#$%&'*+/=?^_`{|}~-]+(\\.[A-Za-z0-9!#$%&'*+/=?^_`{|}~-]+)*$")
+	// domainLabelRegexp matches a domain label as per RFC 1035 section 2.3.1.
+	domainLabelRegexp = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?$`)
+)
+
+// validateHostmaster (schema helper) checks that the SOA hostmaster is a
+// valid mailbox, e.g. hostmaster@example.com.
+func validateHostmaster(v interface{}, k string) (ws []string, es []error) {
+	value := v.(string)
+	parts := strings.Split(value, "@")
+	if len(parts) != 2 || !mailboxLocalPartRegexp.MatchString(parts[0]) {
+		return nil, []error{fmt.Errorf("%q must be a mailbox such as hostmaster@example.com, got %q", k, value)}
+	}
+	domain := strings.TrimSuffix(parts[1], ".")
+	if len(domain) > 253 {
+		return nil, []error{fmt.Errorf("%q domain must be at most 253 characters, got %q", k, value)}
+	}
+	for _, label := range strings.Split(domain, ".") {
+		if !domainLabelRegexp.MatchString(label) {
+			return nil, []error{fmt.Errorf("%q has an invalid domain label %q in %q", k, label, value)}
+		}
+	}
+	return nil, nil
+}
+
+// resolveZoneTSIGKey fills in the TSIG key of a secondary zone from the
+// ns1_tsig_key of the same name, when the key is not set on the zone. It
+// returns the fingerprint of the resolved key.
+func resolveZoneTSIGKey(client *ns1.Client, z *dns.Zone) (string, error) {
+	if z.Secondary == nil || z.Secondary.TSIG == nil {
+		return "", nil
+	}
+	if z.Secondary.TSIG.Key != "" {
+		if z.Secondary.TSIG.Hash == "" {
+			return "", fmt.Errorf("tsig.0.hash must be set along with tsig.0.key")
+		}
+		return "", nil
+	}
+	k, err := getTSIGKey(client, z.Secondary.TSIG.Name)
+	if err != nil {
+		return "", fmt.Errorf("could not read TSIG key %s, set tsig.0.key or create it as a ns1_tsig_key: %s", z.Secondary.TSIG.Name, err)
+	}
+	if z.Secondary.TSIG.Hash == "" {
+		z.Secondary.TSIG.Hash = k.Algorithm
+	}
+	z.Secondary.TSIG.Key = k.Secret
+	return tsigKeyFingerprint(k.Secret), nil
+}
+
+// tsigKeyFingerprint identifies a TSIG secret without storing it in state.
+func tsigKeyFingerprint(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// resourceZoneCustomizeDiffTSIGKey plans an update of a zone whose
+// ns1_tsig_key secret changed since the last apply, so that the new secret
+// reaches the zone. A key that does not exist yet may be created in the same
+// apply, and is only reported when creating or updating the zone.
+func resourceZoneCustomizeDiffTSIGKey(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || d.Get("tsig.#").(int) == 0 || d.Get("tsig.0.key").(string) != "" ||
+		!d.NewValueKnown("tsig.0.key") || !d.NewValueKnown("tsig.0.name") {
+		return nil
+	}
+	name := d.Get("tsig.0.name").(string)
+	k, err := getTSIGKey(meta.(*providerMeta).client, name)
+	if apiNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("could not read TSIG key %s: %s", name, err)
+	}
+	if fingerprint := tsigKeyFingerprint(k.Secret); fingerprint != d.Get("tsig_key_fingerprint").(string) {
+		return d.SetNew("tsig_key_fingerprint", fingerprint)
+	}
+	return nil
+}
+
+// zoneBody is the request body of a zone. It sends the enabled flag of the
+// TSIG key of a secondary zone, which dns.TSIG omits when false.
+type zoneBody struct {
+	*dns.Zone
+	Secondary *zoneSecondaryBody `json:"secondary,omitempty"`
+}
+
+type zoneSecondaryBody struct {
+	*dns.ZoneSecondary
+	TSIG *zoneTSIGBody `json:"tsig,omitempty"`
+}
+
+type zoneTSIGBody struct {
+	*dns.TSIG
+	Enabled bool `json:"enabled"`
+}
+
+func newZoneBody(z *dns.Zone) *zoneBody {
+	b := &zoneBody{Zone: z}
+	if z.Secondary != nil {
+		b.Secondary = &zoneSecondaryBody{ZoneSecondary: z.Secondary}
+		if z.Secondary.TSIG != nil {
+			b.Secondary.TSIG = &zoneTSIGBody{
+				TSIG:    z.Secondary.TSIG,
+				Enabled: z.Secondary.TSIG.Enabled,
+			}
+		}
+	}
+	return b
 }
 
 // resourceZoneCreate creates the given zone in ns1
 func resourceZoneCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ns1.Client)
+	client := meta.(*providerMeta).client
 	z := dns.NewZone(d.Get("zone").(string))
-	resourceToZoneData(z, d)
-	if _, err := client.Zones.Create(z); err != nil {
+	if err := resourceToZoneData(z, d); err != nil {
+		return err
+	}
+	fingerprint, err := resolveZoneTSIGKey(client, z)
+	if err != nil {
+		return err
+	}
+	if link, ok := d.GetOk("link"); ok {
+		target, _, err := client.Zones.Get(link.(string))
+		if err != nil {
+			return fmt.Errorf("could not read link target %s: %s", link, err)
+		}
+		if err := checkZoneLinkTarget(target); err != nil {
+			return err
+		}
+	}
+	path := fmt.Sprintf("zones/%s", z.Zone)
+	if !d.Get("autogenerate_ns_record").(bool) {
+		// The SDK can't ask NS1 not to generate the apex NS records.
+		path += "?autogenerate_ns_record=false"
+	}
+	if _, err := apiRequest(client, "PUT", path, newZoneBody(z), z); err != nil {
 		return err
 	}
 	resourceZoneToResourceData(d, z)
+	d.Set("tsig_key_fingerprint", fingerprint)
+	if d.Get("dnssec").(bool) {
+		if err := setZoneDNSSECEnabled(client, z.Zone, true); err != nil {
+			return err
+		}
+	}
+	if z.Secondary != nil && d.Get("wait_for_transfer").(bool) {
+		return resourceZoneWaitForTransfer(d, client)
+	}
 	return nil
 }
 
+// resourceZoneWaitForTransfer polls a secondary zone until its first
+// successful transfer from the primary, or until the create timeout.
+func resourceZoneWaitForTransfer(d *schema.ResourceData, client *ns1.Client) error {
+	return resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		z, _, err := client.Zones.Get(d.Get("zone").(string))
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		resourceZoneToResourceData(d, z)
+		if z.Secondary == nil {
+			return resource.NonRetryableError(fmt.Errorf("zone %s is no longer a secondary", z.Zone))
+		}
+		if z.Secondary.Error != nil && *z.Secondary.Error != "" {
+			return resource.RetryableError(fmt.Errorf("zone transfer of %s from %s failed: %s", z.Zone, z.Secondary.PrimaryIP, *z.Secondary.Error))
+		}
+		if z.Secondary.LastXfr == 0 {
+			return resource.RetryableError(fmt.Errorf("waiting for first zone transfer of %s from %s, status: %q", z.Zone, z.Secondary.PrimaryIP, z.Secondary.Status))
+		}
+		return nil
+	})
+}
+
 // resourceZoneRead reads the given zone data from ns1
 func resourceZoneRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ns1.Client)
-	z, _, err := client.Zones.Get(d.Get("zone").(string))
+	client := meta.(*providerMeta).client
+	z, dnssec, err := getZoneWithDNSSEC(client, d.Get("zone").(string))
 	if err != nil {
 		return err
 	}
 	resourceZoneToResourceData(d, z)
-	return nil
+	d.Set("dnssec", dnssec)
+	return resourceZoneReadLinkTarget(d, client, z)
+}
+
+// resourceZoneReadLinkTarget sets the SOA of the target of a linked zone.
+func resourceZoneReadLinkTarget(d *schema.ResourceData, client *ns1.Client, z *dns.Zone) error {
+	if z.Link == nil || *z.Link == "" {
+		d.Set("target_soa", nil)
+		return nil
+	}
+	target, _, err := client.Zones.Get(*z.Link)
+	if err == ns1.ErrZoneMissing {
+		log.Printf("[WARN] link target %s of zone %s does not exist", *z.Link, z.Zone)
+		d.Set("target_soa", nil)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return d.Set("target_soa", zoneLinkTargetToMaps(target))
 }
 
 // resourceZoneDelete deletes the given zone from ns1
 func resourceZoneDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ns1.Client)
-	_, err := client.Zones.Delete(d.Get("zone").(string))
+	client := meta.(*providerMeta).client
+	zone := d.Get("zone").(string)
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("zone %s has deletion_protection enabled, disable it before destroying the zone", zone)
+	}
+	if !d.Get("force_destroy").(bool) {
+		z, _, err := client.Zones.Get(zone)
+		if err != nil {
+			return err
+		}
+		if records := nonApexZoneRecords(z); len(records) > 0 {
+			lines := make([]string, len(records))
+			for i, r := range records {
+				lines[i] = fmt.Sprintf("  %s %s", r.Domain, r.Type)
+			}
+			return fmt.Errorf(
+				"zone %s still contains records other than the apex NS and SOA records, destroy them or set force_destroy to delete them with the zone:\n%s",
+				zone, strings.Join(lines, "\n"),
+			)
+		}
+	}
+	_, err := client.Zones.Delete(zone)
 	d.SetId("")
 	return err
 }
 
+// nonApexZoneRecords returns the records of a zone other than the apex NS and
+// SOA records, whether managed by Terraform or not. An ns1_record referencing
+// its zone is destroyed before it, but one using a literal zone name, or any
+// record of a zone being replaced, is still returned. The records of
+// secondary and linked zones come from another zone, and are never returned.
+func nonApexZoneRecords(z *dns.Zone) []*dns.ZoneRecord {
+	if !zoneIsPrimary(z) {
+		return nil
+	}
+	records := make([]*dns.ZoneRecord, 0)
+	for _, r := range z.Records {
+		if !isApexZoneRecord(z, r) {
+			records = append(records, r)
+		}
+	}
+	return records
+}
+
+// isApexZoneRecord reports whether r is the apex NS or SOA record of z, which
+// NS1 manages along with the zone.
+func isApexZoneRecord(z *dns.Zone, r *dns.ZoneRecord) bool {
+	return strings.TrimSuffix(r.Domain, ".") == strings.TrimSuffix(z.Zone, ".") && (r.Type == "NS" || r.Type == "SOA")
+}
+
 // resourceZoneUpdate updates the zone with given params in ns1
 func resourceZoneUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ns1.Client)
+	client := meta.(*providerMeta).client
 	z := dns.NewZone(d.Get("zone").(string))
-	resourceToZoneData(z, d)
-	if _, err := client.Zones.Update(z); err != nil {
+	if err := resourceToZoneData(z, d); err != nil {
+		return err
+	}
+	fingerprint, err := resolveZoneTSIGKey(client, z)
+	if err != nil {
+		return err
+	}
+	if _, err := apiRequest(client, "POST", fmt.Sprintf("zones/%s", z.Zone), newZoneBody(z), z); err != nil {
 		return err
 	}
 	resourceZoneToResourceData(d, z)
+	d.Set("tsig_key_fingerprint", fingerprint)
+	if d.HasChange("dnssec") {
+		if err := setZoneDNSSECEnabled(client, z.Zone, d.Get("dnssec").(bool)); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// zoneImportRecordsSuffix, appended to a zone name on import, also imports
+// every record of the zone as a ns1_record.
+const zoneImportRecordsSuffix = ":records"
+
 func resourceZoneStateFunc(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	d.Set("zone", d.Id())
-	return []*schema.ResourceData{d}, nil
+	d.Set("autogenerate_ns_record", true)
+	d.Set("deletion_protection", false)
+	d.Set("force_destroy", false)
+	if !strings.HasSuffix(d.Id(), zoneImportRecordsSuffix) {
+		d.Set("zone", d.Id())
+		return []*schema.ResourceData{d}, nil
+	}
+
+	name := strings.TrimSuffix(d.Id(), zoneImportRecordsSuffix)
+	d.SetId(name)
+	d.Set("zone", name)
+
+	client := meta.(*providerMeta).client
+	z, _, err := client.Zones.Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	results := []*schema.ResourceData{d}
+	for _, zr := range z.Records {
+		if isApexZoneRecord(z, zr) {
+			continue
+		}
+		r := recordResource().Data(nil)
+		r.SetType("ns1_record")
+		r.SetId(zr.ID)
+		r.Set("zone", z.Zone)
+		r.Set("domain", zr.Domain)
+		r.Set("type", zr.Type)
+		results = append(results, r)
+	}
+	return results, nil
 }
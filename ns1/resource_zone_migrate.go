@@ -0,0 +1,288 @@
+package ns1
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceZoneV0 is the ns1_zone schema up to version 1.5.1 of the provider.
+func resourceZoneV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ttl": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"refresh": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"retry": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"expiry": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"nx_ttl": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"link": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"primary": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"additional_primaries": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"dns_servers": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hostmaster": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"networks": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+	}
+}
+
+// resourceZoneStateUpgradeV0 turns additional_primaries from a list of IPs
+// into a list of ip/port blocks. Ports were always 53 before. It also sets
+// the defaults of arguments added since, so that they don't show up as
+// changes.
+func resourceZoneStateUpgradeV0(rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	rawState["autogenerate_ns_record"] = true
+	rawState["dnssec"] = false
+	rawState["deletion_protection"] = false
+	rawState["force_destroy"] = false
+
+	if v, ok := rawState["additional_primaries"].([]interface{}); ok {
+		additionalPrimaries := make([]interface{}, len(v))
+		for i, ip := range v {
+			additionalPrimaries[i] = map[string]interface{}{
+				"ip":   ip,
+				"port": 53,
+			}
+		}
+		rawState["additional_primaries"] = additionalPrimaries
+	}
+	return rawState, nil
+}
+
+// resourceZoneSchemaV1 is the ns1_zone schema before dns_servers became a list.
+func resourceZoneSchemaV1() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"ttl": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"refresh": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"retry": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"expiry": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"nx_ttl": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"link": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: true,
+		},
+		"primary": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: true,
+		},
+		"primary_port": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"additional_primaries": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"ip": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"port": {
+						Type:     schema.TypeInt,
+						Optional: true,
+						Default:  53,
+					},
+				},
+			},
+		},
+		"primary_enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+		},
+		"secondaries": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"ip": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"port": {
+						Type:     schema.TypeInt,
+						Optional: true,
+						Default:  53,
+					},
+					"notify": {
+						Type:     schema.TypeBool,
+						Optional: true,
+					},
+					"networks": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Computed: true,
+						Elem:     &schema.Schema{Type: schema.TypeInt},
+					},
+				},
+			},
+		},
+		"tsig": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+					"hash": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"key": {
+						Type:      schema.TypeString,
+						Required:  true,
+						Sensitive: true,
+					},
+				},
+			},
+		},
+		"wait_for_transfer": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"transfer_status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"last_transfer": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"expired": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"transfer_error": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"dns_servers": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"hostmaster": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"autogenerate_ns_record": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		"networks": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeInt},
+		},
+		"meta": {
+			Type:     schema.TypeMap,
+			Optional: true,
+		},
+		"dnssec": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+// resourceZoneStateUpgradeV1 turns dns_servers from a comma separated string
+// into a list.
+func resourceZoneStateUpgradeV1(rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	dnsServers := []interface{}{}
+	if v, ok := rawState["dns_servers"].(string); ok && v != "" {
+		for _, server := range strings.Split(v, ",") {
+			dnsServers = append(dnsServers, server)
+		}
+	}
+	rawState["dns_servers"] = dnsServers
+	return rawState, nil
+}
@@ -0,0 +1,64 @@
+package ns1
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestResourceZoneStateUpgradeV0(t *testing.T) {
+	rawState := map[string]interface{}{
+		"zone":                 "terraform-test-zone.io",
+		"primary":              "1.1.1.1",
+		"additional_primaries": []interface{}{"2.2.2.2", "3.3.3.3"},
+	}
+	expected := map[string]interface{}{
+		"zone":                   "terraform-test-zone.io",
+		"primary":                "1.1.1.1",
+		"autogenerate_ns_record": true,
+		"dnssec":                 false,
+		"deletion_protection":    false,
+		"force_destroy":          false,
+		"additional_primaries": []interface{}{
+			map[string]interface{}{"ip": "2.2.2.2", "port": 53},
+			map[string]interface{}{"ip": "3.3.3.3", "port": 53},
+		},
+	}
+
+	actual, err := resourceZoneStateUpgradeV0(rawState, nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("got: %#v\nwant: %#v", actual, expected)
+	}
+}
+
+func TestResourceZoneStateUpgradeV1(t *testing.T) {
+	cases := []struct {
+		dnsServers interface{}
+		expected   []interface{}
+	}{
+		{"dns1.p01.nsone.net,dns2.p01.nsone.net", []interface{}{"dns1.p01.nsone.net", "dns2.p01.nsone.net"}},
+		{"dns1.p01.nsone.net", []interface{}{"dns1.p01.nsone.net"}},
+		{"", []interface{}{}},
+		{nil, []interface{}{}},
+	}
+	for _, c := range cases {
+		rawState := map[string]interface{}{
+			"zone":        "terraform-test-zone.io",
+			"dns_servers": c.dnsServers,
+		}
+		expected := map[string]interface{}{
+			"zone":        "terraform-test-zone.io",
+			"dns_servers": c.expected,
+		}
+
+		actual, err := resourceZoneStateUpgradeV1(rawState, nil)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("got: %#v\nwant: %#v", actual, expected)
+		}
+	}
+}
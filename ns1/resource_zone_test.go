@@ -1,14 +1,15 @@
 package ns1
 
 import (
+	"encoding/json"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 
-	ns1 "gopkg.in/ns1/ns1-go.v2/rest"
 	"gopkg.in/ns1/ns1-go.v2/rest/model/dns"
 )
 
@@ -84,7 +85,7 @@ func TestAccZone_secondary(t *testing.T) {
 		"terraform-test-%s.io",
 		acctest.RandStringFromCharSet(15, acctest.CharSetAlphaNum),
 	)
-	expectedOtherPorts := []int{53, 53}
+	expectedOtherPorts := []int{53, 5353}
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -96,8 +97,11 @@ func TestAccZone_secondary(t *testing.T) {
 					testAccCheckZoneExists("ns1_zone.it", &zone),
 					testAccCheckZoneName(&zone, zoneName),
 					resource.TestCheckResourceAttr("ns1_zone.it", "primary", "1.1.1.1"),
-					resource.TestCheckResourceAttr("ns1_zone.it", "additional_primaries.0", "2.2.2.2"),
-					resource.TestCheckResourceAttr("ns1_zone.it", "additional_primaries.1", "3.3.3.3"),
+					resource.TestCheckResourceAttr("ns1_zone.it", "primary_port", "53"),
+					resource.TestCheckResourceAttr("ns1_zone.it", "additional_primaries.0.ip", "2.2.2.2"),
+					resource.TestCheckResourceAttr("ns1_zone.it", "additional_primaries.0.port", "53"),
+					resource.TestCheckResourceAttr("ns1_zone.it", "additional_primaries.1.ip", "3.3.3.3"),
+					resource.TestCheckResourceAttr("ns1_zone.it", "additional_primaries.1.port", "5353"),
 					testAccCheckOtherPorts(&zone, expectedOtherPorts),
 				),
 			},
@@ -105,6 +109,403 @@ func TestAccZone_secondary(t *testing.T) {
 	})
 }
 
+func TestAccZone_secondaryTSIG(t *testing.T) {
+	var zone dns.Zone
+	zoneName := fmt.Sprintf(
+		"terraform-test-%s.io",
+		acctest.RandStringFromCharSet(15, acctest.CharSetAlphaNum),
+	)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneSecondaryTSIG(zoneName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneExists("ns1_zone.it", &zone),
+					testAccCheckZoneTSIG(&zone, "hmac-sha256", "terraform-test"),
+					resource.TestCheckResourceAttr("ns1_zone.it", "tsig.0.enabled", "true"),
+					resource.TestCheckResourceAttr("ns1_zone.it", "tsig.0.hash", "hmac-sha256"),
+					resource.TestCheckResourceAttr("ns1_zone.it", "tsig.0.name", "terraform-test"),
+				),
+			},
+			{
+				Config: testAccZoneSecondaryTSIG(zoneName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneExists("ns1_zone.it", &zone),
+					testAccCheckZoneTSIGEnabled(&zone, false),
+					resource.TestCheckResourceAttr("ns1_zone.it", "tsig.0.enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccZone_secondaryTSIGKey(t *testing.T) {
+	var zone dns.Zone
+	rString := acctest.RandStringFromCharSet(15, acctest.CharSetAlphaNum)
+	rotatedSecret := "cm90YXRlZC10c2lnLWtleS1mb3ItdGVycmFmb3JtLXRlc3Rz"
+	zoneName := fmt.Sprintf("terraform-test-%s.io", rString)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneSecondaryTSIGKey(zoneName, rString),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneExists("ns1_zone.it", &zone),
+					testAccCheckZoneTSIG(&zone, "hmac-sha512", "terraform-test-"+rString),
+					resource.TestCheckResourceAttr("ns1_zone.it", "tsig.0.hash", "hmac-sha512"),
+					resource.TestCheckResourceAttrSet("ns1_zone.it", "tsig_key_fingerprint"),
+				),
+			},
+			{
+				// Rotating the secret outside of the zone updates the zone.
+				PreConfig: func() {
+					client := testAccProvider.Meta().(*providerMeta).client
+					k := &tsigKey{
+						Name:      "terraform-test-" + rString,
+						Algorithm: "hmac-sha512",
+						Secret:    rotatedSecret,
+					}
+					if _, err := apiRequest(client, "POST", "tsig/"+k.Name, k, nil); err != nil {
+						t.Fatalf("could not rotate TSIG key: %s", err)
+					}
+				},
+				Config: testAccZoneSecondaryTSIGKey(zoneName, rString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ns1_zone.it", "tsig_key_fingerprint", tsigKeyFingerprint(rotatedSecret)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccZone_primary(t *testing.T) {
+	var zone dns.Zone
+	zoneName := fmt.Sprintf(
+		"terraform-test-%s.io",
+		acctest.RandStringFromCharSet(15, acctest.CharSetAlphaNum),
+	)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneSecondaries(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneExists("ns1_zone.it", &zone),
+					testAccCheckZoneSecondaries(&zone, []string{"2.2.2.2", "3.3.3.3"}),
+					resource.TestCheckResourceAttr("ns1_zone.it", "primary_enabled", "true"),
+					resource.TestCheckResourceAttr("ns1_zone.it", "secondaries.#", "2"),
+					resource.TestCheckResourceAttr("ns1_zone.it", "secondaries.0.ip", "2.2.2.2"),
+					resource.TestCheckResourceAttr("ns1_zone.it", "secondaries.0.notify", "true"),
+					resource.TestCheckResourceAttr("ns1_zone.it", "secondaries.1.port", "5353"),
+				),
+			},
+			{
+				Config: testAccZoneBasic(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneExists("ns1_zone.it", &zone),
+					testAccCheckZoneSecondaries(&zone, []string{}),
+					resource.TestCheckResourceAttr("ns1_zone.it", "primary_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccZone_waitForTransferTimeout(t *testing.T) {
+	zoneName := fmt.Sprintf(
+		"terraform-test-%s.io",
+		acctest.RandStringFromCharSet(15, acctest.CharSetAlphaNum),
+	)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccZoneWaitForTransfer(zoneName),
+				ExpectError: regexp.MustCompile(`zone transfer of ` + regexp.QuoteMeta(zoneName)),
+			},
+		},
+	})
+}
+
+func TestAccZone_meta(t *testing.T) {
+	var zone dns.Zone
+	zoneName := fmt.Sprintf(
+		"terraform-test-%s.io",
+		acctest.RandStringFromCharSet(15, acctest.CharSetAlphaNum),
+	)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneMeta(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneExists("ns1_zone.it", &zone),
+					resource.TestCheckResourceAttr("ns1_zone.it", "meta.%", "2"),
+					resource.TestCheckResourceAttr("ns1_zone.it", "meta.georegion", "US-EAST"),
+					resource.TestCheckResourceAttr("ns1_zone.it", "meta.asn", "1234"),
+				),
+			},
+			{
+				Config: testAccZoneBasic(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneExists("ns1_zone.it", &zone),
+					testAccCheckZoneNoMeta(&zone),
+					resource.TestCheckResourceAttr("ns1_zone.it", "meta.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccZone_hostmasterNoNSRecord(t *testing.T) {
+	var zone dns.Zone
+	zoneName := fmt.Sprintf(
+		"terraform-test-%s.io",
+		acctest.RandStringFromCharSet(15, acctest.CharSetAlphaNum),
+	)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneHostmasterNoNSRecord(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneExists("ns1_zone.it", &zone),
+					testAccCheckZoneHostmaster(&zone, "dns-admin@example.com"),
+					testAccCheckZoneNoNSRecord(&zone),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateHostmaster(t *testing.T) {
+	valid := []string{
+		"hostmaster@example.com",
+		"dns.admin@example.com",
+		"dns+ns1@sub.example.com.",
+	}
+	for _, v := range valid {
+		if _, es := validateHostmaster(v, "hostmaster"); len(es) > 0 {
+			t.Errorf("%q: unexpected errors: %v", v, es)
+		}
+	}
+
+	invalid := []string{
+		"hostmaster.example.com",
+		"@example.com",
+		"dns..admin@example.com",
+		"hostmaster@-example.com",
+		"hostmaster@example..com",
+		"a@b@example.com",
+	}
+	for _, v := range invalid {
+		if _, es := validateHostmaster(v, "hostmaster"); len(es) == 0 {
+			t.Errorf("%q: expected an error", v)
+		}
+	}
+}
+
+func TestAccZone_invalidSOA(t *testing.T) {
+	zoneName := fmt.Sprintf(
+		"terraform-test-%s.io",
+		acctest.RandStringFromCharSet(15, acctest.CharSetAlphaNum),
+	)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccZoneInvalidSOA(zoneName),
+				ExpectError: regexp.MustCompile(`retry \(7200\) must be lower than refresh \(3600\)`),
+			},
+			{
+				// refresh is left to the NS1 default of 43200.
+				Config:      testAccZoneInvalidSOARetry(zoneName),
+				ExpectError: regexp.MustCompile(`retry \(90000\) must be lower than refresh \(43200\)`),
+			},
+		},
+	})
+}
+
+func TestAccZone_invalidNetwork(t *testing.T) {
+	zoneName := fmt.Sprintf(
+		"terraform-test-%s.io",
+		acctest.RandStringFromCharSet(15, acctest.CharSetAlphaNum),
+	)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccZoneInvalidNetwork(zoneName),
+				ExpectError: regexp.MustCompile(`networks: unknown network IDs \[999999\]`),
+			},
+		},
+	})
+}
+
+func TestAccZone_deletionProtection(t *testing.T) {
+	zoneName := fmt.Sprintf(
+		"terraform-test-%s.io",
+		acctest.RandStringFromCharSet(15, acctest.CharSetAlphaNum),
+	)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneDeletionProtection(zoneName, true),
+			},
+			{
+				Config:      testAccZoneDeletionProtection(zoneName, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`has deletion_protection enabled`),
+			},
+			{
+				Config: testAccZoneDeletionProtection(zoneName, false),
+			},
+		},
+	})
+}
+
+// A record using a literal zone name does not depend on the zone, so it is
+// still in the zone when the zone is destroyed.
+func TestAccZone_destroyWithLiteralZoneRecord(t *testing.T) {
+	zoneName := fmt.Sprintf(
+		"terraform-test-%s.io",
+		acctest.RandStringFromCharSet(15, acctest.CharSetAlphaNum),
+	)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneBasic(zoneName),
+			},
+			{
+				Config: testAccZoneBasic(zoneName) + testAccLiteralZoneRecord(zoneName),
+			},
+			{
+				Config:      testAccLiteralZoneRecord(zoneName),
+				ExpectError: regexp.MustCompile(`still contains records other than the apex NS and SOA records`),
+			},
+			{
+				Config: testAccZoneBasic(zoneName),
+			},
+		},
+	})
+}
+
+func TestNonApexZoneRecords(t *testing.T) {
+	link := "b.io"
+	records := []*dns.ZoneRecord{
+		{Domain: "a.io", Type: "NS"},
+		{Domain: "a.io", Type: "SOA"},
+		{Domain: "a.io", Type: "MX"},
+		{Domain: "sub.a.io", Type: "NS"},
+	}
+	cases := []struct {
+		zone     *dns.Zone
+		expected int
+	}{
+		{&dns.Zone{Zone: "a.io"}, 0},
+		{&dns.Zone{Zone: "a.io", Records: records}, 2},
+		{&dns.Zone{Zone: "a.io", Records: records, Secondary: &dns.ZoneSecondary{Enabled: true}}, 0},
+		{&dns.Zone{Zone: "a.io", Records: records, Link: &link}, 0},
+	}
+	for i, c := range cases {
+		if got := len(nonApexZoneRecords(c.zone)); got != c.expected {
+			t.Errorf("case %d: got %d records, want %d", i, got, c.expected)
+		}
+	}
+}
+
+func TestZoneBody(t *testing.T) {
+	z := dns.NewZone("a.io")
+	z.MakeSecondary("1.1.1.1")
+	z.Secondary.TSIG = &dns.TSIG{Enabled: false, Hash: "hmac-sha256", Name: "xfr", Key: "c2VjcmV0"}
+	b, err := json.Marshal(newZoneBody(z))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got struct {
+		Zone      string
+		Secondary struct {
+			PrimaryIP string `json:"primary_ip"`
+			TSIG      map[string]interface{}
+		}
+	}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Zone != "a.io" || got.Secondary.PrimaryIP != "1.1.1.1" {
+		t.Errorf("zone: got %s", b)
+	}
+	if enabled, ok := got.Secondary.TSIG["enabled"]; !ok || enabled != false {
+		t.Errorf("tsig.enabled: got %s", b)
+	}
+	if got.Secondary.TSIG["name"] != "xfr" || got.Secondary.TSIG["key"] != "c2VjcmV0" {
+		t.Errorf("tsig: got %s", b)
+	}
+}
+
+func TestAccZone_importWithRecords(t *testing.T) {
+	zoneName := fmt.Sprintf(
+		"terraform-test-%s.io",
+		acctest.RandStringFromCharSet(15, acctest.CharSetAlphaNum),
+	)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneWithRecord(zoneName),
+			},
+			{
+				ResourceName:  "ns1_zone.it",
+				ImportState:   true,
+				ImportStateId: zoneName + zoneImportRecordsSuffix,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					records := 0
+					for _, s := range states {
+						if s.Ephemeral.Type != "ns1_record" {
+							continue
+						}
+						if s.Attributes["domain"] == zoneName {
+							return fmt.Errorf("apex %s record should not be imported", s.Attributes["type"])
+						}
+						if s.Attributes["domain"] == "www."+zoneName {
+							records++
+						}
+					}
+					if records != 1 {
+						return fmt.Errorf("expected www.%s to be imported, got %d matching records", zoneName, records)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
 func testAccCheckZoneExists(n string, zone *dns.Zone) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -117,7 +518,7 @@ func testAccCheckZoneExists(n string, zone *dns.Zone) resource.TestCheckFunc {
 			return fmt.Errorf("NoID is set")
 		}
 
-		client := testAccProvider.Meta().(*ns1.Client)
+		client := testAccProvider.Meta().(*providerMeta).client
 
 		foundZone, _, err := client.Zones.Get(rs.Primary.Attributes["zone"])
 
@@ -138,7 +539,7 @@ func testAccCheckZoneExists(n string, zone *dns.Zone) resource.TestCheckFunc {
 }
 
 func testAccCheckZoneDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ns1.Client)
+	client := testAccProvider.Meta().(*providerMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "ns1_zone" {
@@ -219,6 +620,79 @@ func testAccCheckOtherPorts(zone *dns.Zone, expected []int) resource.TestCheckFu
 	}
 }
 
+func testAccCheckZoneTSIG(zone *dns.Zone, hash, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if zone.Secondary == nil || zone.Secondary.TSIG == nil {
+			return fmt.Errorf("tsig: not set")
+		}
+		if zone.Secondary.TSIG.Hash != hash {
+			return fmt.Errorf("tsig.hash: got: %s want: %s", zone.Secondary.TSIG.Hash, hash)
+		}
+		if zone.Secondary.TSIG.Name != name {
+			return fmt.Errorf("tsig.name: got: %s want: %s", zone.Secondary.TSIG.Name, name)
+		}
+		return nil
+	}
+}
+
+func testAccCheckZoneNoMeta(zone *dns.Zone) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if zone.Meta != nil && len(zone.Meta.StringMap()) > 0 {
+			return fmt.Errorf("meta: got: %v want: none", zone.Meta.StringMap())
+		}
+		return nil
+	}
+}
+
+func testAccCheckZoneTSIGEnabled(zone *dns.Zone, expected bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if zone.Secondary == nil || zone.Secondary.TSIG == nil {
+			return fmt.Errorf("tsig: not set")
+		}
+		if zone.Secondary.TSIG.Enabled != expected {
+			return fmt.Errorf("tsig.enabled: got: %t want: %t", zone.Secondary.TSIG.Enabled, expected)
+		}
+		return nil
+	}
+}
+
+func testAccCheckZoneSecondaries(zone *dns.Zone, expected []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if zone.Primary == nil {
+			return fmt.Errorf("primary: not set")
+		}
+		if len(zone.Primary.Secondaries) != len(expected) {
+			return fmt.Errorf("secondaries: got: %d want: %d", len(zone.Primary.Secondaries), len(expected))
+		}
+		for i, server := range zone.Primary.Secondaries {
+			if server.IP != expected[i] {
+				return fmt.Errorf("secondaries[%d]: got: %s want: %s", i, server.IP, expected[i])
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckZoneHostmaster(zone *dns.Zone, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if zone.Hostmaster != expected {
+			return fmt.Errorf("Hostmaster: got: %s want: %s", zone.Hostmaster, expected)
+		}
+		return nil
+	}
+}
+
+func testAccCheckZoneNoNSRecord(zone *dns.Zone) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, r := range zone.Records {
+			if r.Type == "NS" && r.Domain == zone.Zone {
+				return fmt.Errorf("found autogenerated NS record: %s", r.ShortAns)
+			}
+		}
+		return nil
+	}
+}
+
 func testAccZoneBasic(zoneName string) string {
 	return fmt.Sprintf(`resource "ns1_zone" "it" {
   zone = "%s"
@@ -250,7 +724,162 @@ func testAccZonePrimary(zoneName string) string {
   expiry  = 2592000
   nx_ttl  = 3601
   primary = "1.1.1.1"
-  additional_primaries = ["2.2.2.2", "3.3.3.3"]
+
+  additional_primaries {
+    ip = "2.2.2.2"
+  }
+
+  additional_primaries {
+    ip   = "3.3.3.3"
+    port = 5353
+  }
+}
+`, zoneName)
+}
+
+func testAccZoneWithRecord(zoneName string) string {
+	return fmt.Sprintf(`resource "ns1_zone" "it" {
+  zone = "%s"
+}
+
+resource "ns1_record" "www" {
+  zone   = "${ns1_zone.it.zone}"
+  domain = "www.${ns1_zone.it.zone}"
+  type   = "A"
+
+  answers {
+    answer = "1.2.3.4"
+  }
+}
+`, zoneName)
+}
+
+func testAccZoneSecondaryTSIG(zoneName string, enabled bool) string {
+	return fmt.Sprintf(`resource "ns1_zone" "it" {
+  zone    = "%s"
+  primary = "1.1.1.1"
+
+  tsig {
+    enabled = %t
+    hash    = "hmac-sha256"
+    name    = "terraform-test"
+    key     = "c2VjcmV0LXRzaWcta2V5LWZvci10ZXJyYWZvcm0tdGVzdHM="
+  }
+}
+`, zoneName, enabled)
+}
+
+func testAccZoneSecondaryTSIGKey(zoneName, rString string) string {
+	return fmt.Sprintf(`resource "ns1_tsig_key" "xfr" {
+  name      = "terraform-test-%[2]s"
+  algorithm = "hmac-sha512"
+}
+
+resource "ns1_zone" "it" {
+  zone    = "%[1]s"
+  primary = "1.1.1.1"
+
+  tsig {
+    name = "${ns1_tsig_key.xfr.name}"
+  }
+}
+`, zoneName, rString)
+}
+
+func testAccZoneSecondaries(zoneName string) string {
+	return fmt.Sprintf(`resource "ns1_zone" "it" {
+  zone = "%s"
+
+  secondaries {
+    ip     = "2.2.2.2"
+    notify = true
+  }
+
+  secondaries {
+    ip   = "3.3.3.3"
+    port = 5353
+  }
 }
 `, zoneName)
 }
+
+func testAccZoneWaitForTransfer(zoneName string) string {
+	return fmt.Sprintf(`resource "ns1_zone" "it" {
+  zone              = "%s"
+  primary           = "192.0.2.1"
+  wait_for_transfer = true
+
+  timeouts {
+    create = "1m"
+  }
+}
+`, zoneName)
+}
+
+func testAccZoneMeta(zoneName string) string {
+	return fmt.Sprintf(`resource "ns1_zone" "it" {
+  zone = "%s"
+
+  meta = {
+    georegion = "US-EAST"
+    asn       = "1234"
+  }
+}
+`, zoneName)
+}
+
+func testAccZoneHostmasterNoNSRecord(zoneName string) string {
+	return fmt.Sprintf(`resource "ns1_zone" "it" {
+  zone                   = "%s"
+  hostmaster             = "dns-admin@example.com"
+  autogenerate_ns_record = false
+}
+`, zoneName)
+}
+
+func testAccZoneInvalidSOA(zoneName string) string {
+	return fmt.Sprintf(`resource "ns1_zone" "it" {
+  zone    = "%s"
+  refresh = 3600
+  retry   = 7200
+}
+`, zoneName)
+}
+
+func testAccZoneInvalidSOARetry(zoneName string) string {
+	return fmt.Sprintf(`resource "ns1_zone" "it" {
+  zone  = "%s"
+  retry = 90000
+}
+`, zoneName)
+}
+
+func testAccZoneInvalidNetwork(zoneName string) string {
+	return fmt.Sprintf(`resource "ns1_zone" "it" {
+  zone     = "%s"
+  networks = [999999]
+}
+`, zoneName)
+}
+
+func testAccLiteralZoneRecord(zoneName string) string {
+	return fmt.Sprintf(`
+resource "ns1_record" "www" {
+  zone   = "%[1]s"
+  domain = "www.%[1]s"
+  type   = "A"
+
+  answers {
+    answer = "1.2.3.4"
+  }
+}
+`, zoneName)
+}
+
+func testAccZoneDeletionProtection(zoneName string, protected bool) string {
+	return fmt.Sprintf(`resource "ns1_zone" "it" {
+  zone                = "%s"
+  deletion_protection = %t
+}
+`, zoneName, protected)
+}
@@ -0,0 +1,108 @@
+package ns1
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"gopkg.in/ns1/ns1-go.v2/rest/model/dns"
+)
+
+// maxSOAValue is the largest TTL or SOA timer, per RFC 2181 section 8.
+const maxSOAValue = 1<<31 - 1
+
+// soaRange holds the range of values commonly used for an SOA field. Values
+// outside of it are allowed, but produce a warning.
+type soaRange struct {
+	Min, Max  int
+	Reference string
+}
+
+var soaRanges = map[string]soaRange{
+	"ttl":     {300, 86400, "common practice"},
+	"refresh": {1200, 43200, "RFC 1912 section 2.2"},
+	"retry":   {120, 7200, "common practice"},
+	"expiry":  {1209600, 3600000, "RFC 1912 section 2.2 and RIPE-203"},
+	"nx_ttl":  {300, 86400, "RFC 2308 section 5"},
+}
+
+// soaDefaults are the SOA timers NS1 gives a zone that doesn't set them.
+var soaDefaults = map[string]int{
+	"refresh": 43200,
+	"retry":   7200,
+	"expiry":  1209600,
+	"nx_ttl":  3600,
+}
+
+// soaTimer returns the value of SOA timer k, or its NS1 default when v is
+// zero.
+func soaTimer(k string, v int) int {
+	if v == 0 {
+		return soaDefaults[k]
+	}
+	return v
+}
+
+// validateSOAValue (schema helper) checks that an SOA field is in range, and
+// warns if it's outside of common practice. Zero means the NS1 default.
+func validateSOAValue(v interface{}, k string) (ws []string, es []error) {
+	value := v.(int)
+	if value < 0 || value > maxSOAValue {
+		return nil, []error{fmt.Errorf("%q must be between 0 and %d seconds, got %d", k, maxSOAValue, value)}
+	}
+	if r, ok := soaRanges[k]; ok && value != 0 && (value < r.Min || value > r.Max) {
+		ws = append(ws, fmt.Sprintf("%q is %d seconds, outside of the usual %d to %d seconds (%s)", k, value, r.Min, r.Max, r.Reference))
+	}
+	return ws, nil
+}
+
+// checkSOATimers checks the relationships between SOA timers, as per RFC 1912
+// section 2.2. Zero values are not known yet and are skipped; unset timers
+// must be replaced with their NS1 default by the caller.
+func checkSOATimers(refresh, retry, expiry, nxTTL int) error {
+	if refresh != 0 && retry != 0 && retry >= refresh {
+		return fmt.Errorf("retry (%d) must be lower than refresh (%d): secondaries retry a failed refresh more often than they refresh", retry, refresh)
+	}
+	if refresh != 0 && expiry != 0 && expiry <= refresh+retry {
+		return fmt.Errorf("expiry (%d) must be greater than refresh + retry (%d): secondaries would expire the zone before retrying a failed refresh", expiry, refresh+retry)
+	}
+	if expiry != 0 && nxTTL != 0 && nxTTL > expiry {
+		return fmt.Errorf("nx_ttl (%d) must not be greater than expiry (%d)", nxTTL, expiry)
+	}
+	return nil
+}
+
+// resourceZoneCustomizeDiffSOA checks the SOA timers of a zone when they
+// change.
+func resourceZoneCustomizeDiffSOA(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("link").(string) != "" {
+		// Linked zones use the SOA of their target.
+		return nil
+	}
+	if !d.HasChange("refresh") && !d.HasChange("retry") && !d.HasChange("expiry") && !d.HasChange("nx_ttl") {
+		return nil
+	}
+	// Unset timers are only known at plan time when they are in the state, and
+	// are checked again before the zone is sent.
+	timer := func(k string) int {
+		if !d.NewValueKnown(k) {
+			return 0
+		}
+		return soaTimer(k, d.Get(k).(int))
+	}
+	return checkSOATimers(timer("refresh"), timer("retry"), timer("expiry"), timer("nx_ttl"))
+}
+
+// checkZoneSOATimers checks the SOA timers of a zone about to be sent to NS1,
+// with the NS1 defaults of the timers it doesn't set.
+func checkZoneSOATimers(z *dns.Zone) error {
+	if z.Link != nil && *z.Link != "" {
+		return nil
+	}
+	return checkSOATimers(
+		soaTimer("refresh", z.Refresh),
+		soaTimer("retry", z.Retry),
+		soaTimer("expiry", z.Expiry),
+		soaTimer("nx_ttl", z.NxTTL),
+	)
+}
@@ -0,0 +1,71 @@
+package ns1
+
+import (
+	"testing"
+
+	"gopkg.in/ns1/ns1-go.v2/rest/model/dns"
+)
+
+func TestValidateSOAValue(t *testing.T) {
+	cases := []struct {
+		key      string
+		value    int
+		warnings int
+		errors   int
+	}{
+		{"refresh", 0, 0, 0},
+		{"refresh", 43200, 0, 0},
+		{"refresh", 60, 1, 0},
+		{"expiry", 86400, 1, 0},
+		{"nx_ttl", -1, 0, 1},
+		{"ttl", maxSOAValue + 1, 0, 1},
+	}
+	for _, c := range cases {
+		ws, es := validateSOAValue(c.value, c.key)
+		if len(ws) != c.warnings || len(es) != c.errors {
+			t.Errorf("%s = %d: got %d warnings and %d errors, want %d and %d", c.key, c.value, len(ws), len(es), c.warnings, c.errors)
+		}
+	}
+}
+
+func TestCheckSOATimers(t *testing.T) {
+	cases := []struct {
+		refresh, retry, expiry, nxTTL int
+		valid                         bool
+	}{
+		{43200, 7200, 1209600, 3600, true},
+		{0, 0, 0, 0, true},
+		{3600, 7200, 1209600, 3600, false},
+		{3600, 3600, 1209600, 3600, false},
+		{43200, 7200, 3600, 3600, false},
+		{43200, 7200, 50400, 3600, false},
+		{43200, 7200, 1209600, 2000000, false},
+		{0, 7200, 3600, 0, true},
+	}
+	for _, c := range cases {
+		err := checkSOATimers(c.refresh, c.retry, c.expiry, c.nxTTL)
+		if (err == nil) != c.valid {
+			t.Errorf("%d/%d/%d/%d: got err %v, want valid %t", c.refresh, c.retry, c.expiry, c.nxTTL, err, c.valid)
+		}
+	}
+}
+
+func TestCheckZoneSOATimers(t *testing.T) {
+	link := "b.io"
+	cases := []struct {
+		zone  *dns.Zone
+		valid bool
+	}{
+		{&dns.Zone{Zone: "a.io"}, true},
+		{&dns.Zone{Zone: "a.io", Refresh: 3600, Retry: 600}, true},
+		// retry is checked against the default refresh of 43200.
+		{&dns.Zone{Zone: "a.io", Retry: 90000}, false},
+		{&dns.Zone{Zone: "a.io", Retry: 90000, Link: &link}, true},
+	}
+	for i, c := range cases {
+		err := checkZoneSOATimers(c.zone)
+		if (err == nil) != c.valid {
+			t.Errorf("case %d: got err %v, want valid %t", i, err, c.valid)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package ns1
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// setToStrings converts a *schema.Set of strings to a string slice.
+func setToStrings(s *schema.Set) []string {
+	out := make([]string, 0, s.Len())
+	for _, v := range s.List() {
+		out = append(out, v.(string))
+	}
+	return out
+}
+
+// setToInts converts a *schema.Set of ints to an int slice.
+func setToInts(s *schema.Set) []int {
+	out := make([]int, 0, s.Len())
+	for _, v := range s.List() {
+		out = append(out, v.(int))
+	}
+	return out
+}
+
+// listToStrings converts a schema.TypeList of strings to a string slice.
+func listToStrings(l []interface{}) []string {
+	out := make([]string, 0, len(l))
+	for _, v := range l {
+		out = append(out, v.(string))
+	}
+	return out
+}
+
+// validateCIDR (schema helper) checks that a string is an IP prefix in CIDR
+// notation.
+func validateCIDR(v interface{}, k string) (ws []string, es []error) {
+	if _, _, err := net.ParseCIDR(v.(string)); err != nil {
+		es = append(es, fmt.Errorf("%q must be an IP prefix such as 10.0.0.0/8, got %q", k, v))
+	}
+	return
+}